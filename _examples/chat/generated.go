@@ -3957,15 +3957,15 @@ func (ec *executionContext) marshalNMessage2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalNMessage2githubᚗcomᚋ99designsᚋgqlgenᚋ_examplesᚋchatᚐMessage(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4063,15 +4063,15 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4138,15 +4138,15 @@ func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalN__DirectiveLocation2string(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4194,15 +4194,15 @@ func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4242,15 +4242,15 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4370,15 +4370,15 @@ func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgq
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalN__EnumValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4417,15 +4417,15 @@ func (ec *executionContext) marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalN__Field2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4464,15 +4464,15 @@ func (ec *executionContext) marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4518,15 +4518,15 @@ func (ec *executionContext) marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4026,15 +4026,15 @@ func (ec *executionContext) marshalNTodo2ᚕᚖgithubᚗcomᚋ99designsᚋgqlgen
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalNTodo2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋ_examplesᚋconfigᚐTodo(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4094,15 +4094,15 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4169,15 +4169,15 @@ func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalN__DirectiveLocation2string(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4225,15 +4225,15 @@ func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4273,15 +4273,15 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4434,15 +4434,15 @@ func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgq
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalN__EnumValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4481,15 +4481,15 @@ func (ec *executionContext) marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalN__Field2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4528,15 +4528,15 @@ func (ec *executionContext) marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4582,15 +4582,15 @@ func (ec *executionContext) marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
 		}
 		if isLen1 {
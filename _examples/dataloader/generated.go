@@ -4195,15 +4195,15 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4270,15 +4270,15 @@ func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalN__DirectiveLocation2string(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4326,15 +4326,15 @@ func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4374,15 +4374,15 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4484,15 +4484,15 @@ func (ec *executionContext) marshalOCustomer2ᚕᚕᚖgithubᚗcomᚋ99designs
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalOCustomer2ᚕᚖgithubᚗcomᚋ99designsᚋgqlgenᚋ_examplesᚋdataloaderᚐCustomerᚄ(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4525,15 +4525,15 @@ func (ec *executionContext) marshalOCustomer2ᚕᚖgithubᚗcomᚋ99designsᚋgq
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalNCustomer2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋ_examplesᚋdataloaderᚐCustomer(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4638,15 +4638,15 @@ func (ec *executionContext) marshalOItem2ᚕᚖgithubᚗcomᚋ99designsᚋgqlgen
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalNItem2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋ_examplesᚋdataloaderᚐItem(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4685,15 +4685,15 @@ func (ec *executionContext) marshalOOrder2ᚕᚖgithubᚗcomᚋ99designsᚋgqlge
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalNOrder2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋ_examplesᚋdataloaderᚐOrder(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4750,15 +4750,15 @@ func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgq
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalN__EnumValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4797,15 +4797,15 @@ func (ec *executionContext) marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalN__Field2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4844,15 +4844,15 @@ func (ec *executionContext) marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -4898,15 +4898,15 @@ func (ec *executionContext) marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
 		}
 		if isLen1 {
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/plugin/federation/fedruntime"
 )
 
@@ -87,7 +88,7 @@ func (ec *executionContext) buildRepresentationGroups(
 		if !ok {
 			// If there is no __typename, we just skip the representation;
 			// we just won't be resolving these unknown types.
-			ec.Error(ctx, errors.New("__typename must be an existing string"))
+			ec.Error(graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i)), errors.New("__typename must be an existing string"))
 			continue
 		}
 
@@ -121,7 +122,8 @@ func (ec *executionContext) resolveEntityGroup(
 			go func(i int, rep EntityWithIndex) {
 				entity, err := ec.resolveEntity(ctx, typeName, rep.entity)
 				if err != nil {
-					ec.Error(ctx, err)
+					// report the error at the index of the representation, only that entity is null
+					ec.Error(graphql.WithPathContext(ctx, graphql.NewPathWithIndex(rep.index)), err)
 				} else {
 					list[rep.index] = entity
 				}
@@ -2,12 +2,15 @@ package api
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/99designs/gqlgen/codegen"
 	"github.com/99designs/gqlgen/codegen/config"
 	"github.com/vektah/gqlparser/v2/ast"
 )
@@ -130,3 +133,57 @@ func TestGenerateWithSchemaMutator(t *testing.T) {
 		})
 	}
 }
+
+// testRestPlugin generates a map of REST endpoints from the @rest directive applications.
+type testRestPlugin struct {
+	filename string
+}
+
+func (t *testRestPlugin) Name() string {
+	return "rest"
+}
+
+func (t *testRestPlugin) GenerateCode(data *codegen.Data) error {
+	var sb strings.Builder
+	sb.WriteString("// Code generated by the rest plugin, DO NOT EDIT.\n\npackage graph\n\nvar RestEndpoints = map[string]string{\n")
+	for _, app := range data.DirectiveApplications.ForName("rest") {
+		fmt.Fprintf(&sb, "\t%q: %q,\n", app.TypeName+"."+app.FieldName, app.Args["method"].(string)+" "+app.Args["endpoint"].(string))
+	}
+	sb.WriteString("}\n")
+	return os.WriteFile(t.filename, []byte(sb.String()), 0o644)
+}
+
+func TestGenerateWithDirectivePlugin(t *testing.T) {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+
+	workDir := filepath.Join(wd, "testdata", "directiveplugin")
+	p := &testRestPlugin{filename: filepath.Join(workDir, "graph", "rest_gen.go")}
+	t.Cleanup(func() {
+		cleanup(workDir)
+		_ = os.Remove(p.filename)
+		_ = os.Chdir(wd)
+	})
+
+	err = os.Chdir(workDir)
+	require.NoError(t, err)
+
+	cfg, err := config.LoadConfigFromDefaultLocations()
+	require.NoError(t, err)
+
+	err = Generate(cfg, AddPlugin(p))
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(p.filename)
+	require.NoError(t, err)
+	require.Equal(t, `// Code generated by the rest plugin, DO NOT EDIT.
+
+package graph
+
+var RestEndpoints = map[string]string{
+	"Mutation.createUser": "POST /users",
+	"Query.user": "GET /users/{id}",
+	"Query.users": "GET /users",
+}
+`, string(content))
+}
@@ -45,6 +45,46 @@ func TestClient(t *testing.T) {
 	require.Equal(t, "bob", resp.Name)
 }
 
+func TestClientVars(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"query":"user(input:$input){name}","variables":{"input":{"id":1,"profile":{"displayName":"bob"}},"extra":true}}`, string(b))
+
+			err = json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{
+					"name": "bob",
+				},
+			})
+			assert.NoError(t, err)
+		}
+	})
+
+	c := client.New(h)
+
+	type profile struct {
+		DisplayName string `json:"displayName"`
+		Bio         string `json:"bio,omitempty"`
+	}
+	type vars struct {
+		Input struct {
+			ID      int     `json:"id"`
+			Profile profile `json:"profile"`
+		} `json:"input"`
+	}
+	v := vars{}
+	v.Input.ID = 1
+	v.Input.Profile.DisplayName = "bob"
+
+	var resp struct {
+		Name string
+	}
+
+	c.MustPost("user(input:$input){name}", &resp, client.Vars(v), client.Var("extra", true))
+
+	require.Equal(t, "bob", resp.Name)
+}
+
 func TestClientMultipartFormData(t *testing.T) {
 	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		bodyBytes, err := io.ReadAll(r.Body)
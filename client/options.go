@@ -1,6 +1,12 @@
 package client
 
-import "net/http"
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+)
 
 // Var adds a variable into the outgoing request
 func Var(name string, value any) Option {
@@ -13,6 +19,30 @@ func Var(name string, value any) Option {
 	}
 }
 
+// Vars adds every field of value, a struct or a map, as a variable into the outgoing request. Structs
+// are encoded with encoding/json, so json tags are respected.
+func Vars(value any) Option {
+	return func(bd *Request) {
+		b, err := json.Marshal(value)
+		if err != nil {
+			panic(fmt.Errorf("encode variables: %w", err))
+		}
+
+		var vars map[string]any
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.UseNumber()
+		if err := dec.Decode(&vars); err != nil {
+			panic(fmt.Errorf("variables must encode to a JSON object: %w", err))
+		}
+
+		if bd.Variables == nil {
+			bd.Variables = map[string]any{}
+		}
+
+		maps.Copy(bd.Variables, vars)
+	}
+}
+
 // Operation sets the operation name for the outgoing request
 func Operation(name string) Option {
 	return func(bd *Request) {
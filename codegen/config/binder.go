@@ -202,6 +202,7 @@ type TypeReference struct {
 	Marshaler                *types.Func // When using external marshalling functions this will point to the Marshal function
 	Unmarshaler              *types.Func // When using external marshalling functions this will point to the Unmarshal function
 	IsMarshaler              bool        // Does the type implement graphql.Marshaler and graphql.Unmarshaler
+	IsTextMarshaler          bool        // Does the type implement encoding.TextMarshaler and encoding.TextUnmarshaler
 	IsOmittable              bool        // Is the type wrapped with Omittable
 	IsContext                bool        // Is the Marshaler/Unmarshaller the context version; applies to either the method or interface variety.
 	PointersInUnmarshalInput bool        // Inverse values and pointers in return.
@@ -282,6 +283,11 @@ func (ref *TypeReference) IsMap() bool {
 	return ref.GO == MapType
 }
 
+// IsIntrospection returns true for references to the introspection types, eg __Type
+func (ref *TypeReference) IsIntrospection() bool {
+	return strings.HasPrefix(ref.Definition.Name, "__")
+}
+
 func (ref *TypeReference) UniquenessKey() string {
 	nullability := "O"
 	if ref.GQL.NonNull {
@@ -468,6 +474,9 @@ func (b *Binder) TypeReference(schemaType *ast.Type, bindTarget types.Type) (ret
 
 			ref.Marshaler = underlyingRef.Marshaler
 			ref.Unmarshaler = underlyingRef.Unmarshaler
+		} else if def.Kind == ast.Scalar && hasMethod(t, "MarshalText") && hasMethod(t, "UnmarshalText") {
+			ref.GO = t
+			ref.IsTextMarshaler = true
 		} else {
 			ref.GO = t
 		}
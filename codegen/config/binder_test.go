@@ -267,6 +267,39 @@ func TestEnumBinding(t *testing.T) {
 	require.Equal(t, cf.Schema.Types["Baz"].EnumValues[1], baz.EnumValues[1].Definition)
 }
 
+func TestTextMarshalerBinding(t *testing.T) {
+	cf := Config{}
+	cf.Packages = code.NewPackages()
+	cf.Models = TypeMap{
+		"IP": TypeMapEntry{
+			Model: []string{"net/netip.Addr"},
+		},
+		"String": TypeMapEntry{
+			Model: []string{"github.com/99designs/gqlgen/graphql.String"},
+		},
+	}
+	cf.Schema = gqlparser.MustLoadSchema(&ast.Source{Name: "schema", Input: `
+	type Query {
+	    foo(arg: IP!): String
+	}
+
+	scalar IP
+	`})
+
+	binder := cf.NewBinder()
+
+	ip, err := binder.TypeReference(cf.Schema.Query.Fields.ForName("foo").Arguments.ForName("arg").Type, nil)
+	require.NoError(t, err)
+	require.True(t, ip.IsTextMarshaler)
+	require.False(t, ip.IsMarshaler)
+	require.Nil(t, ip.Marshaler)
+	require.Equal(t, "net/netip.Addr", ip.GO.String())
+
+	str, err := binder.TypeReference(cf.Schema.Query.Fields.ForName("foo").Type, nil)
+	require.NoError(t, err)
+	require.False(t, str.IsTextMarshaler)
+}
+
 func TestTargetBinding(t *testing.T) {
 	cf := Config{}
 	cf.Packages = code.NewPackages()
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"go/token"
 	"go/types"
 	"io"
 	"os"
@@ -26,6 +27,7 @@ type Config struct {
 	Exec                                 ExecConfig                 `yaml:"exec"`
 	Model                                PackageConfig              `yaml:"model,omitempty"`
 	Federation                           PackageConfig              `yaml:"federation,omitempty"`
+	ResolverInterfaces                   PackageConfig              `yaml:"resolver_interfaces,omitempty"`
 	Resolver                             ResolverConfig             `yaml:"resolver,omitempty"`
 	AutoBind                             []string                   `yaml:"autobind"`
 	Models                               TypeMap                    `yaml:"models,omitempty"`
@@ -54,6 +56,7 @@ type Config struct {
 	ReturnPointersInUnmarshalInput bool           `yaml:"return_pointers_in_unmarshalinput,omitempty"`
 	ResolversAlwaysReturnPointers  bool           `yaml:"resolvers_always_return_pointers,omitempty"`
 	NullableInputOmittable         bool           `yaml:"nullable_input_omittable,omitempty"`
+	NilSlicesAsEmptyLists          bool           `yaml:"nil_slices_as_empty_lists,omitempty"`
 	EnableModelJsonOmitemptyTag    *bool          `yaml:"enable_model_json_omitempty_tag,omitempty"`
 	EnableModelJsonOmitzeroTag     *bool          `yaml:"enable_model_json_omitzero_tag,omitempty"`
 	SkipValidation                 bool           `yaml:"skip_validation,omitempty"`
@@ -62,10 +65,45 @@ type Config struct {
 	Packages                       *code.Packages `yaml:"-"`
 	Schema                         *ast.Schema    `yaml:"-"`
 
+	// ModelFieldOrder controls the order of the fields of generated model structs, it defaults to
+	// the order they are declared in the schema.
+	ModelFieldOrder ModelFieldOrder `yaml:"model_field_order,omitempty"`
+
+	// CaseInsensitiveEnums makes the generated enums accept input values in any case, eg. active for
+	// ACTIVE, while still returning them in the case declared in the schema. Queries are validated by
+	// the server, see handler.Server.SetCaseInsensitiveEnums.
+	CaseInsensitiveEnums bool `yaml:"case_insensitive_enums,omitempty"`
+
+	// SubscriptionErrorChannels makes subscription resolvers return a channel of errors next to the
+	// channel of results, eg. (<-chan *Message, <-chan error, error). An error received from it ends
+	// the subscription and is sent to the client as an error message.
+	SubscriptionErrorChannels bool `yaml:"subscription_error_channels,omitempty"`
+
+	// DeprecationReasons generates a DeprecationReasons map in the exec package, holding the reasons of the
+	// fields, arguments and enum values deprecated in the schema keyed by their schema coordinate.
+	DeprecationReasons bool `yaml:"deprecation_reasons,omitempty"`
+
+	// InterfaceCheckPrefix names the marker methods generated for interfaces and their implementers,
+	// it defaults to Is, eg. IsNode().
+	InterfaceCheckPrefix string `yaml:"interface_check_prefix,omitempty"`
+
 	// Deprecated: use Federation instead. Will be removed next release
 	Federated bool `yaml:"federated,omitempty"`
 }
 
+type ModelFieldOrder string
+
+const (
+	// ModelFieldOrderSchema keeps the fields in the order they are declared in the schema.
+	ModelFieldOrderSchema ModelFieldOrder = "schema"
+	// ModelFieldOrderAlphabetical sorts the fields by their Go name, so reordering the schema doesn't
+	// change the generated code.
+	ModelFieldOrderAlphabetical ModelFieldOrder = "alphabetical"
+	// ModelFieldOrderSize puts the fields with the largest alignment and size first, which minimizes
+	// the padding of the struct.
+	ModelFieldOrderSize ModelFieldOrder = "size"
+)
+
 var cfgFilenames = []string{".gqlgen.yml", "gqlgen.yml", "gqlgen.yaml"}
 
 // DefaultConfig creates a copy of the default config
@@ -306,6 +344,10 @@ func (c *Config) injectTypesFromSchema() error {
 		SkipRuntime: true,
 	}
 
+	c.Directives["enumValue"] = DirectiveConfig{
+		SkipRuntime: true,
+	}
+
 	for _, schemaType := range c.Schema.Types {
 		if c.IsRoot(schemaType) {
 			continue
@@ -477,6 +519,17 @@ type TypeMapEntry struct {
 	Fields        map[string]TypeMapField `yaml:"fields,omitempty"`
 	EnumValues    map[string]EnumValue    `yaml:"enum_values,omitempty"`
 
+	// SerialResolution resolves the fields of this type one at a time instead of concurrently.
+	SerialResolution bool `yaml:"serialResolution,omitempty"`
+
+	// Omittable wraps the nullable fields of this input type with graphql.Omittable, overriding
+	// nullable_input_omittable. Field level omittable settings take precedence.
+	Omittable *bool `yaml:"omittable,omitempty"`
+
+	// MapTo lists the models a generated input type gets a To<Model>() method for, which copies the
+	// fields the input shares with the model into a new instance of it.
+	MapTo StringList `yaml:"mapTo,omitempty"`
+
 	// Key is the Go name of the field.
 	ExtraFields      map[string]ModelExtraField `yaml:"extraFields,omitempty"`
 	EmbedExtraFields []ModelExtraField          `yaml:"embedExtraFields,omitempty"`
@@ -502,6 +555,7 @@ type TypeMapField struct {
 	Type string `yaml:"type"`
 
 	Resolver        bool   `yaml:"resolver"`
+	Future          bool   `yaml:"future"`
 	FieldName       string `yaml:"fieldName"`
 	Omittable       *bool  `yaml:"omittable"`
 	GeneratedMethod string `yaml:"-"`
@@ -624,6 +678,27 @@ func (c *Config) check() error {
 			return errors.New("federation and exec must be in the same package")
 		}
 	}
+	if c.ResolverInterfaces.IsDefined() {
+		if err := c.ResolverInterfaces.Check(); err != nil {
+			return fmt.Errorf("config.resolver_interfaces: %w", err)
+		}
+		fileList[c.ResolverInterfaces.ImportPath()] = append(fileList[c.ResolverInterfaces.ImportPath()], FilenamePackage{
+			Filename: c.ResolverInterfaces.Filename,
+			Package:  c.ResolverInterfaces.Package,
+			Declaree: "resolver_interfaces",
+		})
+		if c.ResolverInterfaces.ImportPath() == c.Exec.ImportPath() {
+			return errors.New("resolver_interfaces and exec must be in different packages")
+		}
+	}
+	switch c.ModelFieldOrder {
+	case "", ModelFieldOrderSchema, ModelFieldOrderAlphabetical, ModelFieldOrderSize:
+	default:
+		return fmt.Errorf("invalid model_field_order %s. must be %s, %s or %s", c.ModelFieldOrder, ModelFieldOrderSchema, ModelFieldOrderAlphabetical, ModelFieldOrderSize)
+	}
+	if c.InterfaceCheckPrefix != "" && (!token.IsIdentifier(c.InterfaceCheckPrefix) || !token.IsExported(c.InterfaceCheckPrefix)) {
+		return fmt.Errorf("invalid interface_check_prefix %s. must be an exported Go identifier", c.InterfaceCheckPrefix)
+	}
 	if c.Federated {
 		return errors.New("federated has been removed, instead use\nfederation:\n    filename: path/to/federated.go")
 	}
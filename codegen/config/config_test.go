@@ -179,6 +179,15 @@ func TestConfigCheck(t *testing.T) {
 				require.EqualError(t, config.check(), "exec and federation define the same import path (github.com/99designs/gqlgen/codegen/config/generated) with different package names (generated vs federation)")
 			})
 
+			t.Run("resolver interfaces must not be in exec package", func(t *testing.T) {
+				config := Config{
+					Exec:               ExecConfig{Layout: execLayout, Filename: "generated/exec.go", DirName: "generated"},
+					ResolverInterfaces: PackageConfig{Filename: "generated/resolvers.go"},
+				}
+
+				require.EqualError(t, config.check(), "resolver_interfaces and exec must be in different packages")
+			})
+
 			t.Run("deprecated federated flag raises an error", func(t *testing.T) {
 				config := Config{
 					Exec:      ExecConfig{Layout: execLayout, Filename: "generated/exec.go", DirName: "generated"},
@@ -187,6 +196,24 @@ func TestConfigCheck(t *testing.T) {
 
 				require.EqualError(t, config.check(), "federated has been removed, instead use\nfederation:\n    filename: path/to/federated.go")
 			})
+
+			t.Run("invalid model field order", func(t *testing.T) {
+				config := Config{
+					Exec:            ExecConfig{Layout: execLayout, Filename: "generated/exec.go", DirName: "generated"},
+					ModelFieldOrder: "random",
+				}
+
+				require.EqualError(t, config.check(), "invalid model_field_order random. must be schema, alphabetical or size")
+			})
+
+			t.Run("invalid interface check prefix", func(t *testing.T) {
+				config := Config{
+					Exec:                 ExecConfig{Layout: execLayout, Filename: "generated/exec.go", DirName: "generated"},
+					InterfaceCheckPrefix: "is",
+				}
+
+				require.EqualError(t, config.check(), "invalid interface_check_prefix is. must be an exported Go identifier")
+			})
 		})
 	}
 }
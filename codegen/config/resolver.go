@@ -20,6 +20,9 @@ type ResolverConfig struct {
 	OmitTemplateComment bool           `yaml:"omit_template_comment,omitempty"`
 	ResolverTemplate    string         `yaml:"resolver_template,omitempty"`
 	PreserveResolver    bool           `yaml:"preserve_resolver,omitempty"`
+	MethodPrefix        string         `yaml:"method_prefix,omitempty"`
+	MethodSuffix        string         `yaml:"method_suffix,omitempty"`
+	NamedReturns        bool           `yaml:"named_returns,omitempty"`
 }
 
 type ResolverLayout string
@@ -71,6 +74,11 @@ func (r *ResolverConfig) Check() error {
 	return nil
 }
 
+// MethodName returns the name of the resolver method for a field with the given go name.
+func (r *ResolverConfig) MethodName(goFieldName string) string {
+	return r.MethodPrefix + goFieldName + r.MethodSuffix
+}
+
 func (r *ResolverConfig) ImportPath() string {
 	if r.Dir() == "" {
 		return ""
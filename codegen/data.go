@@ -36,6 +36,10 @@ type Data struct {
 	SubscriptionRoot *Object
 	AugmentedSources []AugmentedSource
 	Plugins          []any
+
+	// DirectiveApplications lists every use of a directive in the schema along with its argument values,
+	// for plugins generating code from custom directives.
+	DirectiveApplications DirectiveApplicationList
 }
 
 func (d *Data) HasEmbeddableSources() bool {
@@ -48,6 +52,28 @@ func (d *Data) HasEmbeddableSources() bool {
 	return hasEmbeddableSources
 }
 
+// Deprecations returns the reasons of the fields, arguments and enum values deprecated in the schema, keyed by
+// their schema coordinate, eg. User.name, User.friends(first:) or Role.ADMIN.
+func (d *Data) Deprecations() map[string]string {
+	res := map[string]string{}
+	for _, a := range d.DirectiveApplications.ForName("deprecated") {
+		var coordinate string
+		switch {
+		case a.EnumValue != "":
+			coordinate = a.TypeName + "." + a.EnumValue
+		case a.ArgumentName != "":
+			coordinate = a.TypeName + "." + a.FieldName + "(" + a.ArgumentName + ":)"
+		case a.FieldName != "":
+			coordinate = a.TypeName + "." + a.FieldName
+		default:
+			continue
+		}
+		reason, _ := a.Args["reason"].(string)
+		res[coordinate] = reason
+	}
+	return res
+}
+
 // AugmentedSource contains extra information about graphql schema files which is not known directly from the Config.Sources data
 type AugmentedSource struct {
 	// path relative to Config.Exec.Filename
@@ -178,6 +204,7 @@ func BuildData(cfg *config.Config, plugins ...any) (*Data, error) {
 	}
 
 	s.ReferencedTypes = b.buildTypes()
+	s.DirectiveApplications = b.buildDirectiveApplications()
 
 	sort.Slice(s.Objects, func(i, j int) bool {
 		return s.Objects[i].Name < s.Objects[j].Name
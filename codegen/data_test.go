@@ -72,3 +72,22 @@ func TestData_Directives(t *testing.T) {
 
 	assert.Equal(t, expected, d.Directives())
 }
+
+func TestData_Deprecations(t *testing.T) {
+	d := Data{
+		DirectiveApplications: DirectiveApplicationList{
+			{Name: "deprecated", Location: ast.LocationFieldDefinition, TypeName: "User", FieldName: "name", Args: map[string]any{"reason": "Use fullName."}},
+			{Name: "deprecated", Location: ast.LocationArgumentDefinition, TypeName: "User", FieldName: "friends", ArgumentName: "first", Args: map[string]any{"reason": "Use limit."}},
+			{Name: "deprecated", Location: ast.LocationInputFieldDefinition, TypeName: "UserInput", FieldName: "nick", Args: map[string]any{"reason": "No longer supported"}},
+			{Name: "deprecated", Location: ast.LocationEnumValue, TypeName: "Role", EnumValue: "GUEST", Args: map[string]any{"reason": "Use VIEWER."}},
+			{Name: "goField", Location: ast.LocationFieldDefinition, TypeName: "User", FieldName: "email", Args: map[string]any{"name": "Mail"}},
+		},
+	}
+
+	assert.Equal(t, map[string]string{
+		"User.name":            "Use fullName.",
+		"User.friends(first:)": "Use limit.",
+		"UserInput.nick":       "No longer supported",
+		"Role.GUEST":           "Use VIEWER.",
+	}, d.Deprecations())
+}
@@ -0,0 +1,115 @@
+package codegen
+
+import (
+	"sort"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// DirectiveApplication is a single use of a directive in the schema, eg @rest(endpoint: "/users") on a field.
+// Unlike Directives, applications of directives that are skipped at runtime are included, so plugins can
+// generate code from directives that have no runtime implementation.
+type DirectiveApplication struct {
+	Name     string
+	Location ast.DirectiveLocation
+	// TypeName is the type the directive is applied to, or the type declaring the field, argument or
+	// enum value it is applied to. Empty for schema directives.
+	TypeName string
+	// FieldName is the field or input field the directive, or the argument it is applied to, belongs to.
+	FieldName string
+	// ArgumentName is the field argument the directive is applied to.
+	ArgumentName string
+	// EnumValue is the enum value the directive is applied to.
+	EnumValue string
+	// Args holds the directive argument values, including defaults from the directive definition.
+	Args     map[string]any
+	Position *ast.Position
+}
+
+type DirectiveApplicationList []*DirectiveApplication
+
+// ForName returns the applications of the named directive
+func (l DirectiveApplicationList) ForName(name string) DirectiveApplicationList {
+	var res DirectiveApplicationList
+	for _, a := range l {
+		if a.Name == name {
+			res = append(res, a)
+		}
+	}
+	return res
+}
+
+func (b *builder) buildDirectiveApplications() DirectiveApplicationList {
+	var res DirectiveApplicationList
+	add := func(list ast.DirectiveList, app DirectiveApplication) {
+		for _, d := range list {
+			a := app
+			a.Name = d.Name
+			a.Args = d.ArgumentMap(nil)
+			a.Position = d.Position
+			res = append(res, &a)
+		}
+	}
+
+	add(b.Schema.SchemaDirectives, DirectiveApplication{Location: ast.LocationSchema})
+
+	names := make([]string, 0, len(b.Schema.Types))
+	for name, def := range b.Schema.Types {
+		if !def.BuiltIn {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		def := b.Schema.Types[name]
+		add(def.Directives, DirectiveApplication{Location: typeLocation(def.Kind), TypeName: def.Name})
+
+		fieldLocation := ast.LocationFieldDefinition
+		if def.Kind == ast.InputObject {
+			fieldLocation = ast.LocationInputFieldDefinition
+		}
+		for _, field := range def.Fields {
+			add(field.Directives, DirectiveApplication{
+				Location:  fieldLocation,
+				TypeName:  def.Name,
+				FieldName: field.Name,
+			})
+			for _, arg := range field.Arguments {
+				add(arg.Directives, DirectiveApplication{
+					Location:     ast.LocationArgumentDefinition,
+					TypeName:     def.Name,
+					FieldName:    field.Name,
+					ArgumentName: arg.Name,
+				})
+			}
+		}
+
+		for _, value := range def.EnumValues {
+			add(value.Directives, DirectiveApplication{
+				Location:  ast.LocationEnumValue,
+				TypeName:  def.Name,
+				EnumValue: value.Name,
+			})
+		}
+	}
+
+	return res
+}
+
+func typeLocation(kind ast.DefinitionKind) ast.DirectiveLocation {
+	switch kind {
+	case ast.Scalar:
+		return ast.LocationScalar
+	case ast.Interface:
+		return ast.LocationInterface
+	case ast.Union:
+		return ast.LocationUnion
+	case ast.Enum:
+		return ast.LocationEnum
+	case ast.InputObject:
+		return ast.LocationInputObject
+	default:
+		return ast.LocationObject
+	}
+}
@@ -26,6 +26,7 @@ type Field struct {
 	GoFieldType      GoFieldType      // The field type in go, if any
 	GoReceiverName   string           // The name of method & var receiver in go, if any
 	GoFieldName      string           // The name of the method or var in go, if any
+	GoResolverName   string           // The name of the resolver method in go, if this field needs a resolver
 	IsResolver       bool             // Does this field need a resolver
 	Args             []*FieldArgument // A list of arguments to be passed to this field
 	MethodHasContext bool             // If this is bound to a go method, does the method also take a context
@@ -34,7 +35,11 @@ type Field struct {
 	Object           *Object          // A link back to the parent object
 	Default          any              // The default value
 	Stream           bool             // does this field return a channel?
+	StreamErrors     bool             // does the resolver of this field also return a channel of errors?
+	IsFuture         bool             // does the resolver of this field return a graphql.FutureFunc?
 	Directives       []*Directive
+
+	namedReturns bool // name the results of the resolver signature, see config.ResolverConfig.NamedReturns
 }
 
 func (b *builder) buildField(obj *Object, field *ast.FieldDefinition) (*Field, error) {
@@ -76,6 +81,18 @@ func (b *builder) buildField(obj *Object, field *ast.FieldDefinition) (*Field, e
 		log.Println(err.Error())
 	}
 
+	if f.IsResolver {
+		f.GoResolverName = b.Config.Resolver.MethodName(f.GoFieldName)
+		f.namedReturns = b.Config.Resolver.NamedReturns
+		f.StreamErrors = obj.Stream && b.Config.SubscriptionErrorChannels
+		if b.Config.Models[obj.Name].Fields[f.Name].Future {
+			if obj.Stream {
+				return nil, fmt.Errorf("%s.%s: subscription fields can't return futures", obj.Name, f.Name)
+			}
+			f.IsFuture = true
+		}
+	}
+
 	if f.IsResolver && b.Config.ResolversAlwaysReturnPointers && !f.TypeReference.IsPtr() && f.TypeReference.IsStruct() {
 		f.TypeReference = b.Binder.PointerTo(f.TypeReference)
 	}
@@ -135,7 +152,7 @@ func (b *builder) bindField(obj *Object, f *Field) (errret error) {
 	case obj.Root:
 		f.IsResolver = true
 		return nil
-	case b.Config.Models[obj.Name].Fields[f.Name].Resolver:
+	case b.Config.Models[obj.Name].Fields[f.Name].Resolver, b.Config.Models[obj.Name].Fields[f.Name].Future:
 		f.IsResolver = true
 		return nil
 	case obj.Type == config.MapType:
@@ -481,9 +498,9 @@ func (f *Field) GoNameUnexported() string {
 func (f *Field) ShortInvocation() string {
 	caser := cases.Title(language.English, cases.NoLower)
 	if f.Object.Kind == ast.InputObject {
-		return fmt.Sprintf("%s().%s(ctx, &it, data)", caser.String(f.Object.Name), f.GoFieldName)
+		return fmt.Sprintf("%s().%s(ctx, &it, data)", caser.String(f.Object.Name), f.GoResolverName)
 	}
-	return fmt.Sprintf("%s().%s(%s)", caser.String(f.Object.Name), f.GoFieldName, f.CallArgs())
+	return fmt.Sprintf("%s().%s(%s)", caser.String(f.Object.Name), f.GoResolverName, f.CallArgs())
 }
 
 func (f *Field) ArgsFunc() string {
@@ -507,7 +524,7 @@ func (f *Field) ResolverType() string {
 		return ""
 	}
 
-	return fmt.Sprintf("%s().%s(%s)", f.Object.Name, f.GoFieldName, f.CallArgs())
+	return fmt.Sprintf("%s().%s(%s)", f.Object.Name, f.GoResolverName, f.CallArgs())
 }
 
 func (f *Field) IsInputObject() bool {
@@ -545,20 +562,55 @@ func (f *Field) ShortResolverSignature(ft *goast.FuncType) string {
 	if f.Object.Stream {
 		result = "<-chan " + result
 	}
+	if f.IsFuture {
+		result = templates.CurrentImports.Lookup("github.com/99designs/gqlgen/graphql") + ".FutureFunc[" + result + "]"
+	}
 	// Named return.
-	var namedV, namedE string
+	var namedV, namedErrs, namedE string
 	if ft != nil {
-		if ft.Results != nil && len(ft.Results.List) > 0 && len(ft.Results.List[0].Names) > 0 {
-			namedV = ft.Results.List[0].Names[0].Name
+		names := make([]string, 0, 3)
+		if ft.Results != nil {
+			for _, field := range ft.Results.List {
+				if len(field.Names) == 0 {
+					names = append(names, "")
+					continue
+				}
+				for _, name := range field.Names {
+					names = append(names, name.Name)
+				}
+			}
+		}
+		if len(names) > 0 {
+			namedV = names[0]
+		}
+		if f.StreamErrors && len(names) > 2 {
+			namedErrs, namedE = names[1], names[2]
+		} else if !f.StreamErrors && len(names) > 1 {
+			namedE = names[1]
 		}
-		if ft.Results != nil && len(ft.Results.List) > 1 && len(ft.Results.List[1].Names) > 0 {
-			namedE = ft.Results.List[1].Names[0].Name
+	} else if f.namedReturns && !f.hasArg("res") && !f.hasArg("err") && (!f.StreamErrors || !f.hasArg("errs")) {
+		namedV, namedE = "res", "err"
+		if f.StreamErrors {
+			namedErrs = "errs"
 		}
 	}
+	if f.StreamErrors {
+		res += fmt.Sprintf(") (%s %s, %s <-chan error, %s error)", namedV, result, namedErrs, namedE)
+		return res
+	}
 	res += fmt.Sprintf(") (%s %s, %s error)", namedV, result, namedE)
 	return res
 }
 
+func (f *Field) hasArg(varName string) bool {
+	for _, arg := range f.Args {
+		if arg.VarName == varName {
+			return true
+		}
+	}
+	return false
+}
+
 func (f *Field) GoResultName() (string, bool) {
 	name := fmt.Sprintf("%v", f.TypeReference.GO)
 	splits := strings.Split(name, "/")
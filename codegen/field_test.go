@@ -194,3 +194,42 @@ func TestField_CallArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestField_ShortResolverSignature(t *testing.T) {
+	subscription := &Object{
+		Definition: &ast2.Definition{Kind: ast2.Object},
+		Root:       true,
+		Stream:     true,
+	}
+	newField := func(streamErrors, namedReturns bool) *Field {
+		return &Field{
+			Object:        subscription,
+			TypeReference: &config.TypeReference{GO: types.Typ[types.String]},
+			Stream:        true,
+			StreamErrors:  streamErrors,
+			namedReturns:  namedReturns,
+		}
+	}
+	prevDecl := func(src string) *ast.FuncType {
+		f, err := parser.ParseFile(token.NewFileSet(), "", "package p\n"+src, 0)
+		require.NoError(t, err)
+		return f.Decls[0].(*ast.FuncDecl).Type
+	}
+
+	t.Run("channel of results", func(t *testing.T) {
+		require.Equal(t, "(ctx context.Context) ( <-chan string,  error)", newField(false, false).ShortResolverDeclaration())
+	})
+
+	t.Run("channels of results and errors", func(t *testing.T) {
+		require.Equal(t, "(ctx context.Context) ( <-chan string,  <-chan error,  error)", newField(true, false).ShortResolverDeclaration())
+	})
+
+	t.Run("named channels of results and errors", func(t *testing.T) {
+		require.Equal(t, "(ctx context.Context) (res <-chan string, errs <-chan error, err error)", newField(true, true).ShortResolverDeclaration())
+	})
+
+	t.Run("keeps the names of the previous declaration", func(t *testing.T) {
+		ft := prevDecl("func F(ctx context.Context) (messages <-chan string, failures <-chan error, err error)")
+		require.Equal(t, "(ctx context.Context) (messages <-chan string, failures <-chan error, err error)", newField(true, false).ShortResolverSignature(ft))
+	})
+}
@@ -21,6 +21,12 @@ func GenerateCode(data *Data) error {
 		return errors.New("missing exec config")
 	}
 
+	if data.Config.ResolverInterfaces.IsDefined() {
+		if err := generateResolverInterfaces(data); err != nil {
+			return err
+		}
+	}
+
 	switch data.Config.Exec.Layout {
 	case config.ExecLayoutSingleFile:
 		return generateSingleFile(data)
@@ -148,6 +154,22 @@ func generateRootFile(data *Data) error {
 	})
 }
 
+//go:embed resolver_interfaces_.gotpl
+var resolverInterfacesTemplate string
+
+// Resolver interfaces file contains only the resolver interfaces, so they can be imported without the
+// rest of the exec package. The exec package aliases them under their usual names.
+func generateResolverInterfaces(data *Data) error {
+	return templates.Render(templates.Options{
+		PackageName:     data.Config.ResolverInterfaces.Package,
+		Template:        resolverInterfacesTemplate,
+		Filename:        data.Config.ResolverInterfaces.Filename,
+		Data:            data,
+		GeneratedHeader: true,
+		Packages:        data.Config.Packages,
+	})
+}
+
 func addObjects(data *Data, builds *map[string]*Data) error {
 	for _, o := range data.Objects {
 		filename := filename(o.Position, data.Config)
@@ -99,3 +99,32 @@ func (b *builder) buildInterface(typ *ast.Definition) (*Interface, error) {
 func (i *InterfaceImplementor) CanBeNil() bool {
 	return config.IsNilable(i.Type)
 }
+
+// InterfaceCase is a case of the type switch on the go type of an interface value. Implementors bound
+// to the same go type share a case, and are told apart by the type resolver registered for the
+// interface with graphql.RegisterTypeResolver.
+type InterfaceCase struct {
+	Type         types.Type
+	Implementors []InterfaceImplementor
+}
+
+// Cases groups the implementors of i by go type, in the order they are evaluated.
+func (i *Interface) Cases() []*InterfaceCase {
+	var cases []*InterfaceCase
+	byType := map[string]*InterfaceCase{}
+	for _, implementor := range i.Implementors {
+		key := types.TypeString(implementor.Type, nil)
+		if c, ok := byType[key]; ok {
+			c.Implementors = append(c.Implementors, implementor)
+			continue
+		}
+		c := &InterfaceCase{Type: implementor.Type, Implementors: []InterfaceImplementor{implementor}}
+		byType[key] = c
+		cases = append(cases, c)
+	}
+	return cases
+}
+
+func (c *InterfaceCase) CanBeNil() bool {
+	return config.IsNilable(c.Type)
+}
@@ -76,6 +76,16 @@ func (ec *executionContext) marshalNEnumTest2githubᚗcomᚋ99designsᚋgqlgen
 	return v
 }
 
+func (ec *executionContext) unmarshalNIntBackedEnum2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐIntBackedEnum(ctx context.Context, v any) (IntBackedEnum, error) {
+	var res IntBackedEnum
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNIntBackedEnum2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐIntBackedEnum(ctx context.Context, sel ast.SelectionSet, v IntBackedEnum) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) unmarshalOInputWithEnumValue2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐInputWithEnumValue(ctx context.Context, v any) (*InputWithEnumValue, error) {
 	if v == nil {
 		return nil, nil
@@ -2,6 +2,7 @@ package followschema
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/99designs/gqlgen/graphql/handler"
@@ -54,3 +55,134 @@ func TestEnumsResolver(t *testing.T) {
 		require.EqualError(t, err, `http 422: {"errors":[{"message":"INVALID is not a valid EnumTest","path":["variable","input","enum"],"extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`)
 	})
 }
+
+func TestIntBackedEnum(t *testing.T) {
+	resolvers := &Stub{}
+	var received IntBackedEnum
+	resolvers.QueryResolver.IntBackedEnum = func(ctx context.Context, arg IntBackedEnum) (IntBackedEnum, error) {
+		received = arg
+		if arg == IntBackedEnumLow {
+			return IntBackedEnumHigh, nil
+		}
+		return arg, nil
+	}
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.POST{})
+	c := client.New(srv)
+
+	t.Run("values use the directive numbers", func(t *testing.T) {
+		require.Equal(t, 1, int(IntBackedEnumLow))
+		require.Equal(t, 5, int(IntBackedEnumMedium))
+		require.Equal(t, 10, int(IntBackedEnumHigh))
+	})
+
+	t.Run("marshals to the graphql name", func(t *testing.T) {
+		var resp struct {
+			IntBackedEnum string
+		}
+		c.MustPost(`query { intBackedEnum(arg: LOW) }`, &resp)
+		require.Equal(t, IntBackedEnumLow, received)
+		require.Equal(t, "HIGH", resp.IntBackedEnum)
+	})
+
+	t.Run("unmarshals variables from the graphql name", func(t *testing.T) {
+		var resp struct {
+			IntBackedEnum string
+		}
+		c.MustPost(`query($arg: IntBackedEnum!) { intBackedEnum(arg: $arg) }`, &resp, client.Var("arg", "MEDIUM"))
+		require.Equal(t, IntBackedEnumMedium, received)
+		require.Equal(t, "MEDIUM", resp.IntBackedEnum)
+	})
+
+	t.Run("invalid variable", func(t *testing.T) {
+		var resp struct {
+			IntBackedEnum string
+		}
+		err := c.Post(`query($arg: IntBackedEnum!) { intBackedEnum(arg: $arg) }`, &resp, client.Var("arg", "INVALID"))
+		require.EqualError(t, err, `http 422: {"errors":[{"message":"INVALID is not a valid IntBackedEnum","path":["variable","arg"],"extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`)
+	})
+
+	t.Run("json round trip", func(t *testing.T) {
+		b, err := json.Marshal(IntBackedEnumMedium)
+		require.NoError(t, err)
+		require.JSONEq(t, `"MEDIUM"`, string(b))
+
+		var e IntBackedEnum
+		require.NoError(t, json.Unmarshal([]byte(`"HIGH"`), &e))
+		require.Equal(t, IntBackedEnumHigh, e)
+	})
+}
+
+func TestCaseInsensitiveEnums(t *testing.T) {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.EnumInInput = func(ctx context.Context, input *InputWithEnumValue) (EnumTest, error) {
+		return input.Enum, nil
+	}
+	resolvers.QueryResolver.IntBackedEnum = func(ctx context.Context, arg IntBackedEnum) (IntBackedEnum, error) {
+		return arg, nil
+	}
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.POST{})
+	srv.SetCaseInsensitiveEnums(true)
+	c := client.New(srv)
+
+	t.Run("literals in any case", func(t *testing.T) {
+		var resp struct {
+			EnumInInput   string
+			IntBackedEnum string
+		}
+		c.MustPost(`query { enumInInput(input: {enum: ok}) intBackedEnum(arg: Medium) }`, &resp)
+		require.Equal(t, "OK", resp.EnumInInput)
+		require.Equal(t, "MEDIUM", resp.IntBackedEnum)
+	})
+
+	t.Run("variables in any case", func(t *testing.T) {
+		var resp struct {
+			EnumInInput   string
+			IntBackedEnum string
+		}
+		c.MustPost(`query ($input: InputWithEnumValue, $arg: IntBackedEnum!) { enumInInput(input: $input) intBackedEnum(arg: $arg) }`, &resp,
+			client.Var("input", map[string]any{"enum": "ok"}),
+			client.Var("arg", "medium"),
+		)
+		require.Equal(t, "OK", resp.EnumInInput)
+		require.Equal(t, "MEDIUM", resp.IntBackedEnum)
+	})
+
+	t.Run("variable defaults in any case", func(t *testing.T) {
+		var resp struct {
+			IntBackedEnum string
+		}
+		c.MustPost(`query ($arg: IntBackedEnum! = high) { intBackedEnum(arg: $arg) }`, &resp)
+		require.Equal(t, "HIGH", resp.IntBackedEnum)
+	})
+
+	t.Run("unknown literals are rejected", func(t *testing.T) {
+		var resp struct {
+			EnumInInput string
+		}
+		err := c.Post(`query { enumInInput(input: {enum: invalid}) }`, &resp)
+		require.EqualError(t, err, `http 422: {"errors":[{"message":"Value \"invalid\" does not exist in \"EnumTest!\" enum.","locations":[{"line":1,"column":35}],"extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`)
+	})
+
+	t.Run("unknown variables are rejected", func(t *testing.T) {
+		var resp struct {
+			IntBackedEnum string
+		}
+		err := c.Post(`query ($arg: IntBackedEnum!) { intBackedEnum(arg: $arg) }`, &resp, client.Var("arg", "invalid"))
+		require.EqualError(t, err, `http 422: {"errors":[{"message":"invalid is not a valid IntBackedEnum","path":["variable","arg"],"extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`)
+	})
+
+	t.Run("case is enforced by default", func(t *testing.T) {
+		srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+		srv.AddTransport(transport.POST{})
+
+		var resp struct {
+			EnumInInput string
+		}
+		err := client.New(srv).Post(`query { enumInInput(input: {enum: ok}) }`, &resp)
+		require.ErrorContains(t, err, `Value \"ok\" does not exist in \"EnumTest!\" enum.`)
+	})
+}
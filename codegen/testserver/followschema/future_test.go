@@ -0,0 +1,130 @@
+package followschema
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestFutures(t *testing.T) {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.FutureJobs = func(ctx context.Context, count int) ([]*FutureJob, error) {
+		jobs := make([]*FutureJob, count)
+		for i := range jobs {
+			jobs[i] = &FutureJob{ID: strconv.Itoa(i)}
+		}
+		return jobs, nil
+	}
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.POST{})
+	c := client.New(srv)
+
+	type resp struct {
+		FutureJobs []struct{ ID, Result string }
+	}
+
+	t.Run("futures are awaited concurrently with siblings", func(t *testing.T) {
+		// each future only resolves once every sibling is awaiting too
+		var awaiting sync.WaitGroup
+		awaiting.Add(3)
+		allAwaiting := make(chan struct{})
+		go func() {
+			awaiting.Wait()
+			close(allAwaiting)
+		}()
+
+		resolvers.FutureJobResolver.Result = func(ctx context.Context, obj *FutureJob) (graphql.FutureFunc[string], error) {
+			return func(ctx context.Context) (string, error) {
+				awaiting.Done()
+				select {
+				case <-allAwaiting:
+				case <-time.After(time.Second):
+					return "", errors.New("siblings were not awaited concurrently")
+				}
+				return "done " + obj.ID, nil
+			}, nil
+		}
+
+		var r resp
+		c.MustPost(`query { futureJobs(count: 3) { id result } }`, &r)
+		require.Len(t, r.FutureJobs, 3)
+		for i, job := range r.FutureJobs {
+			require.Equal(t, "done "+strconv.Itoa(i), job.Result)
+		}
+	})
+
+	t.Run("futures can resolve from channels", func(t *testing.T) {
+		resolvers.FutureJobResolver.Result = func(ctx context.Context, obj *FutureJob) (graphql.FutureFunc[string], error) {
+			ch := make(chan string, 1)
+			go func() { ch <- "sent " + obj.ID }()
+			return graphql.FutureChan(ch), nil
+		}
+
+		var r resp
+		c.MustPost(`query { futureJobs(count: 1) { result } }`, &r)
+		require.Equal(t, "sent 0", r.FutureJobs[0].Result)
+	})
+
+	t.Run("future errors are field errors", func(t *testing.T) {
+		resolvers.FutureJobResolver.Result = func(ctx context.Context, obj *FutureJob) (graphql.FutureFunc[string], error) {
+			return func(ctx context.Context) (string, error) {
+				return "", errors.New("job failed")
+			}, nil
+		}
+
+		err := c.Post(`query { futureJobs(count: 1) { result } }`, &resp{})
+		require.EqualError(t, err, `[{"message":"job failed","path":["futureJobs",0,"result"]}]`)
+	})
+
+	t.Run("field middleware sees the awaited value", func(t *testing.T) {
+		resolvers.FutureJobResolver.Result = func(ctx context.Context, obj *FutureJob) (graphql.FutureFunc[string], error) {
+			return func(ctx context.Context) (string, error) {
+				return "done", nil
+			}, nil
+		}
+
+		var seen any
+		srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+		srv.AddTransport(transport.POST{})
+		srv.AroundFields(func(ctx context.Context, next graphql.Resolver) (any, error) {
+			res, err := next(ctx)
+			if graphql.GetFieldContext(ctx).Field.Name == "result" {
+				seen = res
+			}
+			return res, err
+		})
+
+		var r resp
+		client.New(srv).MustPost(`query { futureJobs(count: 1) { result } }`, &r)
+		require.Equal(t, "done", seen)
+	})
+
+	t.Run("field middleware can return futures", func(t *testing.T) {
+		srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+		srv.AddTransport(transport.POST{})
+		srv.AroundFields(func(ctx context.Context, next graphql.Resolver) (any, error) {
+			if graphql.GetFieldContext(ctx).Field.Name != "id" {
+				return next(ctx)
+			}
+			return graphql.FutureFunc[any](func(ctx context.Context) (any, error) {
+				res, err := next(ctx)
+				return "job " + res.(string), err
+			}), nil
+		})
+
+		var r resp
+		client.New(srv).MustPost(`query { futureJobs(count: 1) { id } }`, &r)
+		require.Equal(t, "job 0", r.FutureJobs[0].ID)
+	})
+}
@@ -1228,7 +1228,8 @@ func (ec *executionContext) _Animal(ctx context.Context, sel ast.SelectionSet, o
 		}
 		return ec._Cat(ctx, sel, obj)
 	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
+		ec.Errorf(ctx, "unexpected type %T for interface Animal", obj)
+		return graphql.Null
 	}
 }
 
@@ -1244,7 +1245,8 @@ func (ec *executionContext) _Mammalian(ctx context.Context, sel ast.SelectionSet
 		}
 		return ec._Horse(ctx, sel, obj)
 	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
+		ec.Errorf(ctx, "unexpected type %T for interface Mammalian", obj)
+		return graphql.Null
 	}
 }
 
@@ -1263,7 +1265,8 @@ func (ec *executionContext) _Node(ctx context.Context, sel ast.SelectionSet, obj
 		}
 		return ec._ConcreteNodeA(ctx, sel, obj)
 	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
+		ec.Errorf(ctx, "unexpected type %T for interface Node", obj)
+		return graphql.Null
 	}
 }
 
@@ -1282,7 +1285,8 @@ func (ec *executionContext) _Shape(ctx context.Context, sel ast.SelectionSet, ob
 		}
 		return ec._Circle(ctx, sel, obj)
 	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
+		ec.Errorf(ctx, "unexpected type %T for interface Shape", obj)
+		return graphql.Null
 	}
 }
 
@@ -1301,7 +1305,8 @@ func (ec *executionContext) _ShapeUnion(ctx context.Context, sel ast.SelectionSe
 		}
 		return ec._Circle(ctx, sel, obj)
 	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
+		ec.Errorf(ctx, "unexpected type %T for union ShapeUnion", obj)
+		return graphql.Null
 	}
 }
 
@@ -1890,15 +1895,15 @@ func (ec *executionContext) marshalOShape2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 		}
 		ctx := graphql.WithFieldContext(ctx, fc)
 		f := func(i int) {
+			if !isLen1 {
+				defer wg.Done()
+			}
 			defer func() {
 				if r := recover(); r != nil {
 					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
+					ret[i] = graphql.Null
 				}
 			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
 			ret[i] = ec.marshalOShape2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐShape(ctx, sel, v[i])
 		}
 		if isLen1 {
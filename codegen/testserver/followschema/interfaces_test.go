@@ -204,6 +204,45 @@ func TestInterfaces(t *testing.T) {
 		require.Equal(t, "Child", resp.Node.Child.ID)
 	})
 
+	t.Run("list element of an unexpected type is null", func(t *testing.T) {
+		resolvers := &Stub{}
+		resolvers.QueryResolver.Shapes = func(ctx context.Context) (shapes []Shape, err error) {
+			return []Shape{&Circle{Radius: 1}, unknownShape{}, &Circle{Radius: 2}}, nil
+		}
+
+		srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+		srv.AddTransport(transport.POST{})
+		c := client.New(srv)
+
+		var resp struct {
+			Shapes []*struct{ Radius float64 }
+		}
+		err := c.Post(`{ shapes { ... on Circle { radius } } }`, &resp)
+		require.EqualError(t, err, `[{"message":"unexpected type followschema.unknownShape for interface Shape","path":["shapes",1]}]`)
+		require.Len(t, resp.Shapes, 3)
+		require.InDelta(t, 1, resp.Shapes[0].Radius, 0.02)
+		require.Nil(t, resp.Shapes[1])
+		require.InDelta(t, 2, resp.Shapes[2].Radius, 0.02)
+	})
+
+	t.Run("unexpected types are reported", func(t *testing.T) {
+		resolvers := &Stub{}
+		resolvers.QueryResolver.Node = func(ctx context.Context) (Node, error) {
+			return unknownNode{}, nil
+		}
+
+		srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+		srv.AddTransport(transport.POST{})
+		c := client.New(srv)
+
+		var resp struct {
+			Node *struct{ ID string }
+		}
+		err := c.Post(`{ node { id } }`, &resp)
+		require.EqualError(t, err, `[{"message":"unexpected type followschema.unknownNode for interface Node","path":["node"]}]`)
+		require.Nil(t, resp.Node)
+	})
+
 	t.Run("interface implementors should return merged base fields", func(t *testing.T) {
 		resolvers := &Stub{}
 		resolvers.QueryResolver.Shapes = func(ctx context.Context) (shapes []Shape, err error) {
@@ -305,3 +344,14 @@ func TestInterfaces(t *testing.T) {
 		require.Equal(t, 35, resp.Dog.Size.Weight)
 	})
 }
+
+// unknownShape implements Shape without being one of its types in the schema.
+type unknownShape struct{}
+
+func (unknownShape) Area() float64 { return 0 }
+func (unknownShape) isShape()      {}
+
+// unknownNode implements Node without being one of its types in the schema.
+type unknownNode struct{}
+
+func (unknownNode) Child() (Node, error) { return nil, nil }
@@ -27,6 +27,356 @@ import (
 
 // region    **************************** field.gotpl *****************************
 
+func (ec *executionContext) _MapDynamic_id(ctx context.Context, field graphql.CollectedField, obj map[string]any) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MapDynamic_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		switch v := obj["id"].(type) {
+		case string:
+			return v, nil
+		case nil:
+			return nil, nil
+		default:
+			// coerce values of other types, eg. decoded from JSON, to the type of the field
+			return ec.unmarshalNID2string(ctx, v)
+		}
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MapDynamic_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MapDynamic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MapDynamic_count(ctx context.Context, field graphql.CollectedField, obj map[string]any) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MapDynamic_count(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		switch v := obj["count"].(type) {
+		case int:
+			return v, nil
+		case nil:
+			return nil, nil
+		default:
+			// coerce values of other types, eg. decoded from JSON, to the type of the field
+			return ec.unmarshalNInt2int(ctx, v)
+		}
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MapDynamic_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MapDynamic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MapDynamic_ratio(ctx context.Context, field graphql.CollectedField, obj map[string]any) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MapDynamic_ratio(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		switch v := obj["ratio"].(type) {
+		case *float64:
+			return v, nil
+		case float64:
+			return &v, nil
+		case nil:
+			return (*float64)(nil), nil
+		default:
+			// coerce values of other types, eg. decoded from JSON, to the type of the field
+			return ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+		}
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MapDynamic_ratio(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MapDynamic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MapDynamic_enabled(ctx context.Context, field graphql.CollectedField, obj map[string]any) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MapDynamic_enabled(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		switch v := obj["enabled"].(type) {
+		case bool:
+			return v, nil
+		case nil:
+			return nil, nil
+		default:
+			// coerce values of other types, eg. decoded from JSON, to the type of the field
+			return ec.unmarshalNBoolean2bool(ctx, v)
+		}
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MapDynamic_enabled(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MapDynamic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MapDynamic_status(ctx context.Context, field graphql.CollectedField, obj map[string]any) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MapDynamic_status(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		switch v := obj["status"].(type) {
+		case *Status:
+			return v, nil
+		case Status:
+			return &v, nil
+		case nil:
+			return (*Status)(nil), nil
+		default:
+			// coerce values of other types, eg. decoded from JSON, to the type of the field
+			return ec.unmarshalOStatus2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐStatus(ctx, v)
+		}
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*Status)
+	fc.Result = res
+	return ec.marshalOStatus2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MapDynamic_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MapDynamic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Status does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MapDynamic_scores(ctx context.Context, field graphql.CollectedField, obj map[string]any) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MapDynamic_scores(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		switch v := obj["scores"].(type) {
+		case []int:
+			return v, nil
+		case nil:
+			return ([]int)(nil), nil
+		default:
+			// coerce values of other types, eg. decoded from JSON, to the type of the field
+			return ec.unmarshalOInt2ᚕintᚄ(ctx, v)
+		}
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]int)
+	fc.Result = res
+	return ec.marshalOInt2ᚕintᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MapDynamic_scores(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MapDynamic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MapDynamic_child(ctx context.Context, field graphql.CollectedField, obj map[string]any) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MapDynamic_child(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (any, error) {
+		ctx = rctx // use context from middleware stack in children
+		switch v := obj["child"].(type) {
+		case map[string]any:
+			return v, nil
+		case nil:
+			return (map[string]any)(nil), nil
+		default:
+			return nil, fmt.Errorf("unexpected type %T for field %s", v, "child")
+		}
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(map[string]any)
+	fc.Result = res
+	return ec.marshalOMapDynamic2map(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MapDynamic_child(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MapDynamic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_MapDynamic_id(ctx, field)
+			case "count":
+				return ec.fieldContext_MapDynamic_count(ctx, field)
+			case "ratio":
+				return ec.fieldContext_MapDynamic_ratio(ctx, field)
+			case "enabled":
+				return ec.fieldContext_MapDynamic_enabled(ctx, field)
+			case "status":
+				return ec.fieldContext_MapDynamic_status(ctx, field)
+			case "scores":
+				return ec.fieldContext_MapDynamic_scores(ctx, field)
+			case "child":
+				return ec.fieldContext_MapDynamic_child(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MapDynamic", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _MapNested_value(ctx context.Context, field graphql.CollectedField, obj *MapNested) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_MapNested_value(ctx, field)
 	if err != nil {
@@ -90,7 +440,8 @@ func (ec *executionContext) _MapStringInterfaceType_a(ctx context.Context, field
 		case nil:
 			return (*string)(nil), nil
 		default:
-			return nil, fmt.Errorf("unexpected type %T for field %s", v, "a")
+			// coerce values of other types, eg. decoded from JSON, to the type of the field
+			return ec.unmarshalOString2ᚖstring(ctx, v)
 		}
 	})
 
@@ -137,7 +488,8 @@ func (ec *executionContext) _MapStringInterfaceType_b(ctx context.Context, field
 		case nil:
 			return (*int)(nil), nil
 		default:
-			return nil, fmt.Errorf("unexpected type %T for field %s", v, "b")
+			// coerce values of other types, eg. decoded from JSON, to the type of the field
+			return ec.unmarshalOInt2ᚖint(ctx, v)
 		}
 	})
 
@@ -184,7 +536,8 @@ func (ec *executionContext) _MapStringInterfaceType_c(ctx context.Context, field
 		case nil:
 			return (*CustomScalar)(nil), nil
 		default:
-			return nil, fmt.Errorf("unexpected type %T for field %s", v, "c")
+			// coerce values of other types, eg. decoded from JSON, to the type of the field
+			return ec.unmarshalOCustomScalar2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐCustomScalar(ctx, v)
 		}
 	})
 
@@ -374,6 +727,63 @@ func (ec *executionContext) unmarshalInputNestedMapInput(ctx context.Context, ob
 
 // region    **************************** object.gotpl ****************************
 
+var mapDynamicImplementors = []string{"MapDynamic"}
+
+func (ec *executionContext) _MapDynamic(ctx context.Context, sel ast.SelectionSet, obj map[string]any) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mapDynamicImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MapDynamic")
+		case "id":
+			out.Values[i] = ec._MapDynamic_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "count":
+			out.Values[i] = ec._MapDynamic_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "ratio":
+			out.Values[i] = ec._MapDynamic_ratio(ctx, field, obj)
+		case "enabled":
+			out.Values[i] = ec._MapDynamic_enabled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._MapDynamic_status(ctx, field, obj)
+		case "scores":
+			out.Values[i] = ec._MapDynamic_scores(ctx, field, obj)
+		case "child":
+			out.Values[i] = ec._MapDynamic_child(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var mapNestedImplementors = []string{"MapNested"}
 
 func (ec *executionContext) _MapNested(ctx context.Context, sel ast.SelectionSet, obj *MapNested) graphql.Marshaler {
@@ -485,6 +895,13 @@ func (ec *executionContext) marshalOCustomScalar2ᚖgithubᚗcomᚋ99designsᚋg
 	return v
 }
 
+func (ec *executionContext) marshalOMapDynamic2map(ctx context.Context, sel ast.SelectionSet, v map[string]any) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._MapDynamic(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOMapNested2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐMapNested(ctx context.Context, sel ast.SelectionSet, v *MapNested) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -2,6 +2,8 @@ package followschema
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/99designs/gqlgen/graphql/handler/transport"
@@ -84,6 +86,70 @@ func TestMaps(t *testing.T) {
 	})
 }
 
+func TestMapModelCoercion(t *testing.T) {
+	resolver := &Stub{}
+	resolver.QueryResolver.MapDynamic = func(ctx context.Context) (map[string]any, error) {
+		// values proxied from another service keep the types they were decoded with
+		dec := json.NewDecoder(strings.NewReader(`{
+			"id": 1, "count": 2, "ratio": 0.5, "enabled": true, "status": "OK", "scores": [1, "2", 3],
+			"child": {"id": "child", "count": "4", "enabled": false, "scores": null}
+		}`))
+		dec.UseNumber()
+		var res map[string]any
+		err := dec.Decode(&res)
+		return res, err
+	}
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolver}))
+	srv.AddTransport(transport.POST{})
+	c := client.New(srv)
+
+	t.Run("values are coerced to the type of their field", func(t *testing.T) {
+		var resp struct {
+			MapDynamic struct {
+				ID      string
+				Count   int
+				Ratio   float64
+				Enabled bool
+				Status  string
+				Scores  []int
+				Child   struct {
+					ID      string
+					Count   int
+					Ratio   *float64
+					Enabled bool
+					Scores  []int
+				}
+			}
+		}
+		c.MustPost(`{ mapDynamic { id count ratio enabled status scores child { id count ratio enabled scores } } }`, &resp)
+		require.Equal(t, "1", resp.MapDynamic.ID)
+		require.Equal(t, 2, resp.MapDynamic.Count)
+		require.InDelta(t, 0.5, resp.MapDynamic.Ratio, 0.0001)
+		require.True(t, resp.MapDynamic.Enabled)
+		require.Equal(t, "OK", resp.MapDynamic.Status)
+		require.Equal(t, []int{1, 2, 3}, resp.MapDynamic.Scores)
+		require.Equal(t, "child", resp.MapDynamic.Child.ID)
+		require.Equal(t, 4, resp.MapDynamic.Child.Count)
+		require.Nil(t, resp.MapDynamic.Child.Ratio)
+		require.False(t, resp.MapDynamic.Child.Enabled)
+		require.Nil(t, resp.MapDynamic.Child.Scores)
+	})
+
+	t.Run("values that can't be coerced are errors", func(t *testing.T) {
+		resolver.QueryResolver.MapDynamic = func(ctx context.Context) (map[string]any, error) {
+			return map[string]any{"id": "1", "count": "many", "enabled": true}, nil
+		}
+
+		var resp struct {
+			MapDynamic *struct{ ID string }
+		}
+		err := c.Post(`{ mapDynamic { id count } }`, &resp)
+		require.EqualError(t, err, `[{"message":"strconv.Atoi: parsing \"many\": invalid syntax","path":["mapDynamic","count"]}]`)
+		require.Nil(t, resp.MapDynamic)
+	})
+}
+
 func validateMapItemsType(t *testing.T, in map[string]any) {
 	for k, v := range in {
 		switch k {
@@ -31,6 +31,12 @@ func TestNullBubbling(t *testing.T) {
 	resolvers.QueryResolver.ErrorList = func(ctx context.Context) (i []*Error, e error) {
 		return []*Error{nil}, nil
 	}
+	resolvers.QueryResolver.ErrorNestedList = func(ctx context.Context) ([][]*Error, error) {
+		return [][]*Error{{{ID: "1"}}, {{ID: "2"}, nil}}, nil
+	}
+	resolvers.QueryResolver.ErrorNestedNonNullList = func(ctx context.Context) ([][]*Error, error) {
+		return [][]*Error{{{ID: "1"}}, {{ID: "2"}, nil}}, nil
+	}
 
 	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
 	srv.AddTransport(transport.POST{})
@@ -106,6 +112,30 @@ func TestNullBubbling(t *testing.T) {
 		require.Equal(t, "Ok", resp.Valid)
 	})
 
+	t.Run("when non-null element of a nullable inner list is null", func(t *testing.T) {
+		var resp struct {
+			Valid           string
+			ErrorNestedList [][]*struct{ ID string }
+		}
+		err := c.Post(`query { valid, errorNestedList { id } }`, &resp)
+
+		require.EqualError(t, err, `[{"message":"the requested element is null which the schema does not allow","path":["errorNestedList",1,1]}]`)
+		require.Equal(t, [][]*struct{ ID string }{{{ID: "1"}}, nil}, resp.ErrorNestedList)
+		require.Equal(t, "Ok", resp.Valid)
+	})
+
+	t.Run("when non-null element of a non-null inner list is null", func(t *testing.T) {
+		var resp struct {
+			Valid                  string
+			ErrorNestedNonNullList [][]*struct{ ID string }
+		}
+		err := c.Post(`query { valid, errorNestedNonNullList { id } }`, &resp)
+
+		require.EqualError(t, err, `[{"message":"the requested element is null which the schema does not allow","path":["errorNestedNonNullList",1,1]}]`)
+		require.Nil(t, resp.ErrorNestedNonNullList)
+		require.Equal(t, "Ok", resp.Valid)
+	})
+
 	t.Run("null args", func(t *testing.T) {
 		var resp struct {
 			NullableArg *string
@@ -147,4 +177,14 @@ func TestNullBubbling(t *testing.T) {
 		require.Error(t, err)
 		require.Contains(t, err.Error(), `{"message":"ERROR","path":["invalid"]}`)
 	})
+
+	t.Run("when function returns joined errors", func(t *testing.T) {
+		resolvers.QueryResolver.Invalid = func(ctx context.Context) (s string, e error) {
+			return "", errors.Join(errors.New("ERROR 1"), errors.New("ERROR 2"))
+		}
+
+		var resp any
+		err := c.Post(`query { invalid }`, &resp)
+		require.EqualError(t, err, `[{"message":"ERROR 1","path":["invalid"]},{"message":"ERROR 2","path":["invalid"]}]`)
+	})
 }
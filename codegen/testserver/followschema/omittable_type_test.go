@@ -0,0 +1,63 @@
+package followschema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestInputTypeOmittable(t *testing.T) {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.InputTypeOmittable = func(ctx context.Context, arg TypeOmittableInput) (string, error) {
+		value, isSet := arg.Name.ValueOK()
+		if !isSet {
+			return "<unset>", nil
+		}
+
+		if value == nil {
+			return "<nil>", nil
+		}
+
+		return *value, nil
+	}
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.POST{})
+	c := client.New(srv)
+
+	var resp struct {
+		InputTypeOmittable string
+	}
+
+	t.Run("omitted", func(t *testing.T) {
+		c.MustPost(`query { inputTypeOmittable(arg: { required: "x" }) }`, &resp)
+		require.Equal(t, "<unset>", resp.InputTypeOmittable)
+
+		c.MustPost(`query($arg: TypeOmittableInput!) { inputTypeOmittable(arg: $arg) }`, &resp,
+			client.Var("arg", map[string]any{"required": "x"}))
+		require.Equal(t, "<unset>", resp.InputTypeOmittable)
+	})
+
+	t.Run("explicit null", func(t *testing.T) {
+		c.MustPost(`query { inputTypeOmittable(arg: { name: null, required: "x" }) }`, &resp)
+		require.Equal(t, "<nil>", resp.InputTypeOmittable)
+
+		c.MustPost(`query($arg: TypeOmittableInput!) { inputTypeOmittable(arg: $arg) }`, &resp,
+			client.Var("arg", map[string]any{"name": nil, "required": "x"}))
+		require.Equal(t, "<nil>", resp.InputTypeOmittable)
+	})
+
+	t.Run("value", func(t *testing.T) {
+		c.MustPost(`query { inputTypeOmittable(arg: { name: "foo", required: "x" }) }`, &resp)
+		require.Equal(t, "foo", resp.InputTypeOmittable)
+
+		c.MustPost(`query($arg: TypeOmittableInput!) { inputTypeOmittable(arg: $arg) }`, &resp,
+			client.Var("arg", map[string]any{"name": "foo", "required": "x"}))
+		require.Equal(t, "foo", resp.InputTypeOmittable)
+	})
+}
@@ -0,0 +1,48 @@
+package followschema
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestOperationContextOperation(t *testing.T) {
+	resolvers := &Stub{}
+	var mu sync.Mutex
+	var operation *ast.OperationDefinition
+	var doc *ast.QueryDocument
+	resolvers.QueryResolver.Valid = func(ctx context.Context) (string, error) {
+		opCtx := graphql.GetOperationContext(ctx)
+		mu.Lock()
+		defer mu.Unlock()
+		operation = opCtx.Operation
+		doc = opCtx.Doc
+		return "Ok", nil
+	}
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.POST{})
+	c := client.New(srv)
+
+	var resp struct {
+		Valid string
+		Again string
+	}
+	c.MustPost(`query A { valid } query B { valid again: valid }`, &resp, client.Operation("B"))
+
+	require.Len(t, doc.Operations, 2)
+	require.Same(t, doc.Operations.ForName("B"), operation)
+	require.Equal(t, "B", operation.Name)
+	require.Equal(t, ast.Query, operation.Operation)
+	require.Len(t, operation.SelectionSet, 2)
+	require.Equal(t, "valid", operation.SelectionSet[0].(*ast.Field).Name)
+	require.Equal(t, "again", operation.SelectionSet[1].(*ast.Field).Alias)
+}
@@ -2,6 +2,7 @@ package followschema
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -69,3 +70,32 @@ func TestPanics(t *testing.T) {
 		require.EqualError(t, err, "http 422: {\"errors\":[{\"message\":\"presented: panic: BOOM\"}],\"data\":null}")
 	})
 }
+
+func TestPanicsRecoverWithOperation(t *testing.T) {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.Panics = func(ctx context.Context) (panics *Panics, e error) {
+		return &Panics{}, nil
+	}
+	resolvers.PanicsResolver.ArgUnmarshal = func(ctx context.Context, obj *Panics, u []MarshalPanic) (b bool, e error) {
+		return true, nil
+	}
+
+	var recovered *graphql.RecoveredOperation
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.POST{})
+	srv.SetRecoverFunc(graphql.RecoverWithOperation(func(ctx context.Context, err any, op *graphql.RecoveredOperation) error {
+		recovered = op
+		return errors.New("internal system error")
+	}))
+
+	c := client.New(srv)
+
+	var resp any
+	err := c.Post(`query Panic($u: [MarshalPanic!]!) { panics { argUnmarshal(u: $u) } }`, &resp, client.Var("u", []string{"aa"}))
+	require.EqualError(t, err, `[{"message":"internal system error","path":["panics","argUnmarshal"]}]`)
+
+	require.NotNil(t, recovered)
+	require.Equal(t, "Panic", recovered.OperationName)
+	require.Equal(t, `query Panic($u: [MarshalPanic!]!) { panics { argUnmarshal(u: $u) } }`, recovered.RawQuery)
+	require.Equal(t, map[string]any{"u": "[redacted]"}, recovered.Variables)
+}
@@ -8,10 +8,26 @@ import (
 	introspection1 "github.com/99designs/gqlgen/codegen/testserver/followschema/introspection"
 	invalid_packagename "github.com/99designs/gqlgen/codegen/testserver/followschema/invalid-packagename"
 	"github.com/99designs/gqlgen/codegen/testserver/followschema/otherpkg"
+	"github.com/99designs/gqlgen/graphql"
 )
 
 type Resolver struct{}
 
+// First is the resolver for the first field.
+func (r *abortableStepsResolver) First(ctx context.Context, obj *AbortableSteps) (*int, error) {
+	panic("not implemented")
+}
+
+// Second is the resolver for the second field.
+func (r *abortableStepsResolver) Second(ctx context.Context, obj *AbortableSteps) (*int, error) {
+	panic("not implemented")
+}
+
+// Third is the resolver for the third field.
+func (r *abortableStepsResolver) Third(ctx context.Context, obj *AbortableSteps) (*int, error) {
+	panic("not implemented")
+}
+
 // ID is the resolver for the id field.
 func (r *backedByInterfaceResolver) ID(ctx context.Context, obj BackedByInterface) (string, error) {
 	panic("not implemented")
@@ -52,6 +68,16 @@ func (r *forcedResolverResolver) Field(ctx context.Context, obj *ForcedResolver)
 	panic("not implemented")
 }
 
+// Result is the resolver for the result field.
+func (r *futureJobResolver) Result(ctx context.Context, obj *FutureJob) (graphql.FutureFunc[string], error) {
+	panic("not implemented")
+}
+
+// Expensive is the resolver for the expensive field.
+func (r *lazyFieldsResolver) Expensive(ctx context.Context, obj *LazyFields) (string, error) {
+	panic("not implemented")
+}
+
 // ResolverField is the resolver for the resolverField field.
 func (r *modelMethodsResolver) ResolverField(ctx context.Context, obj *ModelMethods) (bool, error) {
 	panic("not implemented")
@@ -187,6 +213,11 @@ func (r *queryResolver) DeprecatedField(ctx context.Context) (string, error) {
 	panic("not implemented")
 }
 
+// AbortableSteps is the resolver for the abortableSteps field.
+func (r *queryResolver) AbortableSteps(ctx context.Context) (*AbortableSteps, error) {
+	panic("not implemented")
+}
+
 // Overlapping is the resolver for the overlapping field.
 func (r *queryResolver) Overlapping(ctx context.Context) (*OverlappingFields, error) {
 	panic("not implemented")
@@ -287,6 +318,16 @@ func (r *queryResolver) EnumInInput(ctx context.Context, input *InputWithEnumVal
 	panic("not implemented")
 }
 
+// IntBackedEnum is the resolver for the intBackedEnum field.
+func (r *queryResolver) IntBackedEnum(ctx context.Context, arg IntBackedEnum) (IntBackedEnum, error) {
+	panic("not implemented")
+}
+
+// FutureJobs is the resolver for the futureJobs field.
+func (r *queryResolver) FutureJobs(ctx context.Context, count int) ([]*FutureJob, error) {
+	panic("not implemented")
+}
+
 // Shapes is the resolver for the shapes field.
 func (r *queryResolver) Shapes(ctx context.Context) ([]Shape, error) {
 	panic("not implemented")
@@ -327,6 +368,11 @@ func (r *queryResolver) Issue896a(ctx context.Context) ([]*CheckIssue896, error)
 	panic("not implemented")
 }
 
+// LazyFields is the resolver for the lazyFields field.
+func (r *queryResolver) LazyFields(ctx context.Context) (*LazyFields, error) {
+	panic("not implemented")
+}
+
 // MapStringInterface is the resolver for the mapStringInterface field.
 func (r *queryResolver) MapStringInterface(ctx context.Context, in map[string]interface{}) (map[string]interface{}, error) {
 	panic("not implemented")
@@ -337,6 +383,11 @@ func (r *queryResolver) MapNestedStringInterface(ctx context.Context, in *Nested
 	panic("not implemented")
 }
 
+// MapDynamic is the resolver for the mapDynamic field.
+func (r *queryResolver) MapDynamic(ctx context.Context) (map[string]interface{}, error) {
+	panic("not implemented")
+}
+
 // ErrorBubble is the resolver for the errorBubble field.
 func (r *queryResolver) ErrorBubble(ctx context.Context) (*Error, error) {
 	panic("not implemented")
@@ -352,6 +403,16 @@ func (r *queryResolver) ErrorList(ctx context.Context) ([]*Error, error) {
 	panic("not implemented")
 }
 
+// ErrorNestedList is the resolver for the errorNestedList field.
+func (r *queryResolver) ErrorNestedList(ctx context.Context) ([][]*Error, error) {
+	panic("not implemented")
+}
+
+// ErrorNestedNonNullList is the resolver for the errorNestedNonNullList field.
+func (r *queryResolver) ErrorNestedNonNullList(ctx context.Context) ([][]*Error, error) {
+	panic("not implemented")
+}
+
 // Errors is the resolver for the errors field.
 func (r *queryResolver) Errors(ctx context.Context) (*Errors, error) {
 	panic("not implemented")
@@ -367,6 +428,11 @@ func (r *queryResolver) Invalid(ctx context.Context) (string, error) {
 	panic("not implemented")
 }
 
+// InputTypeOmittable is the resolver for the inputTypeOmittable field.
+func (r *queryResolver) InputTypeOmittable(ctx context.Context, arg TypeOmittableInput) (string, error) {
+	panic("not implemented")
+}
+
 // Panics is the resolver for the panics field.
 func (r *queryResolver) Panics(ctx context.Context) (*Panics, error) {
 	panic("not implemented")
@@ -412,6 +478,26 @@ func (r *queryResolver) DefaultScalar(ctx context.Context, arg string) (string,
 	panic("not implemented")
 }
 
+// DefaultCustomScalar is the resolver for the defaultCustomScalar field.
+func (r *queryResolver) DefaultCustomScalar(ctx context.Context, arg *Email) (*Email, error) {
+	panic("not implemented")
+}
+
+// TextValue is the resolver for the textValue field.
+func (r *queryResolver) TextValue(ctx context.Context, in TextValue) (*TextValue, error) {
+	panic("not implemented")
+}
+
+// TextValues is the resolver for the textValues field.
+func (r *queryResolver) TextValues(ctx context.Context, in []*TextValue) ([]*TextValue, error) {
+	panic("not implemented")
+}
+
+// SerialResolution is the resolver for the serialResolution field.
+func (r *queryResolver) SerialResolution(ctx context.Context) (*SerialResolution, error) {
+	panic("not implemented")
+}
+
 // Slices is the resolver for the slices field.
 func (r *queryResolver) Slices(ctx context.Context) (*Slices, error) {
 	panic("not implemented")
@@ -427,6 +513,11 @@ func (r *queryResolver) Fallback(ctx context.Context, arg FallbackToStringEncodi
 	panic("not implemented")
 }
 
+// DynamicAnimals is the resolver for the dynamicAnimals field.
+func (r *queryResolver) DynamicAnimals(ctx context.Context) ([]DynamicAnimal, error) {
+	panic("not implemented")
+}
+
 // OptionalUnion is the resolver for the optionalUnion field.
 func (r *queryResolver) OptionalUnion(ctx context.Context) (TestUnion, error) {
 	panic("not implemented")
@@ -472,6 +563,21 @@ func (r *queryResolver) WrappedSlice(ctx context.Context) (WrappedSlice, error)
 	panic("not implemented")
 }
 
+// First is the resolver for the first field.
+func (r *serialResolutionResolver) First(ctx context.Context, obj *SerialResolution) (int, error) {
+	panic("not implemented")
+}
+
+// Second is the resolver for the second field.
+func (r *serialResolutionResolver) Second(ctx context.Context, obj *SerialResolution) (int, error) {
+	panic("not implemented")
+}
+
+// Third is the resolver for the third field.
+func (r *serialResolutionResolver) Third(ctx context.Context, obj *SerialResolution) (int, error) {
+	panic("not implemented")
+}
+
 // Updated is the resolver for the updated field.
 func (r *subscriptionResolver) Updated(ctx context.Context) (<-chan string, error) {
 	panic("not implemented")
@@ -532,6 +638,9 @@ func (r *wrappedSliceResolver) Get(ctx context.Context, obj WrappedSlice, idx in
 	panic("not implemented")
 }
 
+// AbortableSteps returns AbortableStepsResolver implementation.
+func (r *Resolver) AbortableSteps() AbortableStepsResolver { return &abortableStepsResolver{r} }
+
 // BackedByInterface returns BackedByInterfaceResolver implementation.
 func (r *Resolver) BackedByInterface() BackedByInterfaceResolver {
 	return &backedByInterfaceResolver{r}
@@ -546,6 +655,12 @@ func (r *Resolver) Errors() ErrorsResolver { return &errorsResolver{r} }
 // ForcedResolver returns ForcedResolverResolver implementation.
 func (r *Resolver) ForcedResolver() ForcedResolverResolver { return &forcedResolverResolver{r} }
 
+// FutureJob returns FutureJobResolver implementation.
+func (r *Resolver) FutureJob() FutureJobResolver { return &futureJobResolver{r} }
+
+// LazyFields returns LazyFieldsResolver implementation.
+func (r *Resolver) LazyFields() LazyFieldsResolver { return &lazyFieldsResolver{r} }
+
 // ModelMethods returns ModelMethodsResolver implementation.
 func (r *Resolver) ModelMethods() ModelMethodsResolver { return &modelMethodsResolver{r} }
 
@@ -572,6 +687,9 @@ func (r *Resolver) PrimitiveString() PrimitiveStringResolver { return &primitive
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// SerialResolution returns SerialResolutionResolver implementation.
+func (r *Resolver) SerialResolution() SerialResolutionResolver { return &serialResolutionResolver{r} }
+
 // Subscription returns SubscriptionResolver implementation.
 func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
 
@@ -584,10 +702,13 @@ func (r *Resolver) WrappedMap() WrappedMapResolver { return &wrappedMapResolver{
 // WrappedSlice returns WrappedSliceResolver implementation.
 func (r *Resolver) WrappedSlice() WrappedSliceResolver { return &wrappedSliceResolver{r} }
 
+type abortableStepsResolver struct{ *Resolver }
 type backedByInterfaceResolver struct{ *Resolver }
 type deferModelResolver struct{ *Resolver }
 type errorsResolver struct{ *Resolver }
 type forcedResolverResolver struct{ *Resolver }
+type futureJobResolver struct{ *Resolver }
+type lazyFieldsResolver struct{ *Resolver }
 type modelMethodsResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type overlappingFieldsResolver struct{ *Resolver }
@@ -596,6 +717,7 @@ type petResolver struct{ *Resolver }
 type primitiveResolver struct{ *Resolver }
 type primitiveStringResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type serialResolutionResolver struct{ *Resolver }
 type subscriptionResolver struct{ *Resolver }
 type userResolver struct{ *Resolver }
 type wrappedMapResolver struct{ *Resolver }
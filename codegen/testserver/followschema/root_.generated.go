@@ -34,10 +34,13 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	AbortableSteps() AbortableStepsResolver
 	BackedByInterface() BackedByInterfaceResolver
 	DeferModel() DeferModelResolver
 	Errors() ErrorsResolver
 	ForcedResolver() ForcedResolverResolver
+	FutureJob() FutureJobResolver
+	LazyFields() LazyFieldsResolver
 	ModelMethods() ModelMethodsResolver
 	Mutation() MutationResolver
 	OverlappingFields() OverlappingFieldsResolver
@@ -46,6 +49,7 @@ type ResolverRoot interface {
 	Primitive() PrimitiveResolver
 	PrimitiveString() PrimitiveStringResolver
 	Query() QueryResolver
+	SerialResolution() SerialResolutionResolver
 	Subscription() SubscriptionResolver
 	User() UserResolver
 	WrappedMap() WrappedMapResolver
@@ -85,6 +89,12 @@ type ComplexityRoot struct {
 		ID func(childComplexity int) int
 	}
 
+	AbortableSteps struct {
+		First  func(childComplexity int) int
+		Second func(childComplexity int) int
+		Third  func(childComplexity int) int
+	}
+
 	Autobind struct {
 		IdInt func(childComplexity int) int
 		IdStr func(childComplexity int) int
@@ -160,6 +170,14 @@ type ComplexityRoot struct {
 		Species  func(childComplexity int) int
 	}
 
+	DynamicCat struct {
+		Name func(childComplexity int) int
+	}
+
+	DynamicDog struct {
+		Name func(childComplexity int) int
+	}
+
 	EmbeddedCase1 struct {
 		ExportedEmbeddedPointerExportedMethod func(childComplexity int) int
 	}
@@ -204,6 +222,11 @@ type ComplexityRoot struct {
 		Field func(childComplexity int) int
 	}
 
+	FutureJob struct {
+		ID     func(childComplexity int) int
+		Result func(childComplexity int) int
+	}
+
 	Horse struct {
 		HorseBreed func(childComplexity int) int
 		Size       func(childComplexity int) int
@@ -222,6 +245,11 @@ type ComplexityRoot struct {
 		ID func(childComplexity int) int
 	}
 
+	LazyFields struct {
+		Expensive func(childComplexity int) int
+		ID        func(childComplexity int) int
+	}
+
 	LoopA struct {
 		B func(childComplexity int) int
 	}
@@ -234,6 +262,16 @@ type ComplexityRoot struct {
 		ID func(childComplexity int) int
 	}
 
+	MapDynamic struct {
+		Child   func(childComplexity int) int
+		Count   func(childComplexity int) int
+		Enabled func(childComplexity int) int
+		ID      func(childComplexity int) int
+		Ratio   func(childComplexity int) int
+		Scores  func(childComplexity int) int
+		Status  func(childComplexity int) int
+	}
+
 	MapNested struct {
 		Value func(childComplexity int) int
 	}
@@ -321,9 +359,11 @@ type ComplexityRoot struct {
 	}
 
 	Query struct {
+		AbortableSteps                   func(childComplexity int) int
 		Animal                           func(childComplexity int) int
 		Autobind                         func(childComplexity int) int
 		Collision                        func(childComplexity int) int
+		DefaultCustomScalar              func(childComplexity int, arg *Email) int
 		DefaultParameters                func(childComplexity int, falsyBoolean *bool, truthyBoolean *bool) int
 		DefaultScalar                    func(childComplexity int, arg string) int
 		DeferMultiple                    func(childComplexity int) int
@@ -342,6 +382,7 @@ type ComplexityRoot struct {
 		DirectiveSingleNullableArg       func(childComplexity int, arg1 *string) int
 		DirectiveUnimplemented           func(childComplexity int) int
 		Dog                              func(childComplexity int) int
+		DynamicAnimals                   func(childComplexity int) int
 		EmbeddedCase1                    func(childComplexity int) int
 		EmbeddedCase2                    func(childComplexity int) int
 		EmbeddedCase3                    func(childComplexity int) int
@@ -349,15 +390,22 @@ type ComplexityRoot struct {
 		ErrorBubble                      func(childComplexity int) int
 		ErrorBubbleList                  func(childComplexity int) int
 		ErrorList                        func(childComplexity int) int
+		ErrorNestedList                  func(childComplexity int) int
+		ErrorNestedNonNullList           func(childComplexity int) int
 		Errors                           func(childComplexity int) int
 		Fallback                         func(childComplexity int, arg FallbackToStringEncoding) int
+		FutureJobs                       func(childComplexity int, count int) int
 		Infinity                         func(childComplexity int) int
 		InputNullableSlice               func(childComplexity int, arg []string) int
 		InputOmittable                   func(childComplexity int, arg OmittableInput) int
 		InputSlice                       func(childComplexity int, arg []string) int
+		InputTypeOmittable               func(childComplexity int, arg TypeOmittableInput) int
+		IntBackedEnum                    func(childComplexity int, arg IntBackedEnum) int
 		Invalid                          func(childComplexity int) int
 		InvalidIdentifier                func(childComplexity int) int
 		Issue896a                        func(childComplexity int) int
+		LazyFields                       func(childComplexity int) int
+		MapDynamic                       func(childComplexity int) int
 		MapInput                         func(childComplexity int, input map[string]interface{}) int
 		MapNestedStringInterface         func(childComplexity int, in *NestedMapInput) int
 		MapStringInterface               func(childComplexity int, in map[string]interface{}) int
@@ -378,11 +426,14 @@ type ComplexityRoot struct {
 		PtrToSliceContainer              func(childComplexity int) int
 		Recursive                        func(childComplexity int, input *RecursiveInputSlice) int
 		ScalarSlice                      func(childComplexity int) int
+		SerialResolution                 func(childComplexity int) int
 		ShapeUnion                       func(childComplexity int) int
 		Shapes                           func(childComplexity int) int
 		Slices                           func(childComplexity int) int
 		StringFromContextFunction        func(childComplexity int) int
 		StringFromContextInterface       func(childComplexity int) int
+		TextValue                        func(childComplexity int, in TextValue) int
+		TextValues                       func(childComplexity int, in []*TextValue) int
 		User                             func(childComplexity int, id int) int
 		VOkCaseNil                       func(childComplexity int) int
 		VOkCaseValue                     func(childComplexity int) int
@@ -402,6 +453,12 @@ type ComplexityRoot struct {
 		Width       func(childComplexity int) int
 	}
 
+	SerialResolution struct {
+		First  func(childComplexity int) int
+		Second func(childComplexity int) int
+		Third  func(childComplexity int) int
+	}
+
 	Size struct {
 		Height func(childComplexity int) int
 		Weight func(childComplexity int) int
@@ -522,6 +579,27 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.AbIt.ID(childComplexity), true
 
+	case "AbortableSteps.first":
+		if e.complexity.AbortableSteps.First == nil {
+			break
+		}
+
+		return e.complexity.AbortableSteps.First(childComplexity), true
+
+	case "AbortableSteps.second":
+		if e.complexity.AbortableSteps.Second == nil {
+			break
+		}
+
+		return e.complexity.AbortableSteps.Second(childComplexity), true
+
+	case "AbortableSteps.third":
+		if e.complexity.AbortableSteps.Third == nil {
+			break
+		}
+
+		return e.complexity.AbortableSteps.Third(childComplexity), true
+
 	case "Autobind.idInt":
 		if e.complexity.Autobind.IdInt == nil {
 			break
@@ -753,6 +831,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Dog.Species(childComplexity), true
 
+	case "DynamicCat.name":
+		if e.complexity.DynamicCat.Name == nil {
+			break
+		}
+
+		return e.complexity.DynamicCat.Name(childComplexity), true
+
+	case "DynamicDog.name":
+		if e.complexity.DynamicDog.Name == nil {
+			break
+		}
+
+		return e.complexity.DynamicDog.Name(childComplexity), true
+
 	case "EmbeddedCase1.exportedEmbeddedPointerExportedMethod":
 		if e.complexity.EmbeddedCase1.ExportedEmbeddedPointerExportedMethod == nil {
 			break
@@ -872,6 +964,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.ForcedResolver.Field(childComplexity), true
 
+	case "FutureJob.id":
+		if e.complexity.FutureJob.ID == nil {
+			break
+		}
+
+		return e.complexity.FutureJob.ID(childComplexity), true
+
+	case "FutureJob.result":
+		if e.complexity.FutureJob.Result == nil {
+			break
+		}
+
+		return e.complexity.FutureJob.Result(childComplexity), true
+
 	case "Horse.horseBreed":
 		if e.complexity.Horse.HorseBreed == nil {
 			break
@@ -914,6 +1020,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.It.ID(childComplexity), true
 
+	case "LazyFields.expensive":
+		if e.complexity.LazyFields.Expensive == nil {
+			break
+		}
+
+		return e.complexity.LazyFields.Expensive(childComplexity), true
+
+	case "LazyFields.id":
+		if e.complexity.LazyFields.ID == nil {
+			break
+		}
+
+		return e.complexity.LazyFields.ID(childComplexity), true
+
 	case "LoopA.b":
 		if e.complexity.LoopA.B == nil {
 			break
@@ -935,6 +1055,55 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Map.ID(childComplexity), true
 
+	case "MapDynamic.child":
+		if e.complexity.MapDynamic.Child == nil {
+			break
+		}
+
+		return e.complexity.MapDynamic.Child(childComplexity), true
+
+	case "MapDynamic.count":
+		if e.complexity.MapDynamic.Count == nil {
+			break
+		}
+
+		return e.complexity.MapDynamic.Count(childComplexity), true
+
+	case "MapDynamic.enabled":
+		if e.complexity.MapDynamic.Enabled == nil {
+			break
+		}
+
+		return e.complexity.MapDynamic.Enabled(childComplexity), true
+
+	case "MapDynamic.id":
+		if e.complexity.MapDynamic.ID == nil {
+			break
+		}
+
+		return e.complexity.MapDynamic.ID(childComplexity), true
+
+	case "MapDynamic.ratio":
+		if e.complexity.MapDynamic.Ratio == nil {
+			break
+		}
+
+		return e.complexity.MapDynamic.Ratio(childComplexity), true
+
+	case "MapDynamic.scores":
+		if e.complexity.MapDynamic.Scores == nil {
+			break
+		}
+
+		return e.complexity.MapDynamic.Scores(childComplexity), true
+
+	case "MapDynamic.status":
+		if e.complexity.MapDynamic.Status == nil {
+			break
+		}
+
+		return e.complexity.MapDynamic.Status(childComplexity), true
+
 	case "MapNested.value":
 		if e.complexity.MapNested.Value == nil {
 			break
@@ -1236,6 +1405,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.PtrToSliceContainer.PtrToSlice(childComplexity), true
 
+	case "Query.abortableSteps":
+		if e.complexity.Query.AbortableSteps == nil {
+			break
+		}
+
+		return e.complexity.Query.AbortableSteps(childComplexity), true
+
 	case "Query.animal":
 		if e.complexity.Query.Animal == nil {
 			break
@@ -1257,6 +1433,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.Collision(childComplexity), true
 
+	case "Query.defaultCustomScalar":
+		if e.complexity.Query.DefaultCustomScalar == nil {
+			break
+		}
+
+		args, err := ec.field_Query_defaultCustomScalar_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.DefaultCustomScalar(childComplexity, args["arg"].(*Email)), true
+
 	case "Query.defaultParameters":
 		if e.complexity.Query.DefaultParameters == nil {
 			break
@@ -1428,6 +1616,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.Dog(childComplexity), true
 
+	case "Query.dynamicAnimals":
+		if e.complexity.Query.DynamicAnimals == nil {
+			break
+		}
+
+		return e.complexity.Query.DynamicAnimals(childComplexity), true
+
 	case "Query.embeddedCase1":
 		if e.complexity.Query.EmbeddedCase1 == nil {
 			break
@@ -1482,6 +1677,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.ErrorList(childComplexity), true
 
+	case "Query.errorNestedList":
+		if e.complexity.Query.ErrorNestedList == nil {
+			break
+		}
+
+		return e.complexity.Query.ErrorNestedList(childComplexity), true
+
+	case "Query.errorNestedNonNullList":
+		if e.complexity.Query.ErrorNestedNonNullList == nil {
+			break
+		}
+
+		return e.complexity.Query.ErrorNestedNonNullList(childComplexity), true
+
 	case "Query.errors":
 		if e.complexity.Query.Errors == nil {
 			break
@@ -1501,6 +1710,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.Fallback(childComplexity, args["arg"].(FallbackToStringEncoding)), true
 
+	case "Query.futureJobs":
+		if e.complexity.Query.FutureJobs == nil {
+			break
+		}
+
+		args, err := ec.field_Query_futureJobs_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.FutureJobs(childComplexity, args["count"].(int)), true
+
 	case "Query.infinity":
 		if e.complexity.Query.Infinity == nil {
 			break
@@ -1544,6 +1765,30 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.InputSlice(childComplexity, args["arg"].([]string)), true
 
+	case "Query.inputTypeOmittable":
+		if e.complexity.Query.InputTypeOmittable == nil {
+			break
+		}
+
+		args, err := ec.field_Query_inputTypeOmittable_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.InputTypeOmittable(childComplexity, args["arg"].(TypeOmittableInput)), true
+
+	case "Query.intBackedEnum":
+		if e.complexity.Query.IntBackedEnum == nil {
+			break
+		}
+
+		args, err := ec.field_Query_intBackedEnum_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.IntBackedEnum(childComplexity, args["arg"].(IntBackedEnum)), true
+
 	case "Query.invalid":
 		if e.complexity.Query.Invalid == nil {
 			break
@@ -1565,6 +1810,20 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.Issue896a(childComplexity), true
 
+	case "Query.lazyFields":
+		if e.complexity.Query.LazyFields == nil {
+			break
+		}
+
+		return e.complexity.Query.LazyFields(childComplexity), true
+
+	case "Query.mapDynamic":
+		if e.complexity.Query.MapDynamic == nil {
+			break
+		}
+
+		return e.complexity.Query.MapDynamic(childComplexity), true
+
 	case "Query.mapInput":
 		if e.complexity.Query.MapInput == nil {
 			break
@@ -1735,6 +1994,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.ScalarSlice(childComplexity), true
 
+	case "Query.serialResolution":
+		if e.complexity.Query.SerialResolution == nil {
+			break
+		}
+
+		return e.complexity.Query.SerialResolution(childComplexity), true
+
 	case "Query.shapeUnion":
 		if e.complexity.Query.ShapeUnion == nil {
 			break
@@ -1770,6 +2036,30 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.StringFromContextInterface(childComplexity), true
 
+	case "Query.textValue":
+		if e.complexity.Query.TextValue == nil {
+			break
+		}
+
+		args, err := ec.field_Query_textValue_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.TextValue(childComplexity, args["in"].(TextValue)), true
+
+	case "Query.textValues":
+		if e.complexity.Query.TextValues == nil {
+			break
+		}
+
+		args, err := ec.field_Query_textValues_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.TextValues(childComplexity, args["in"].([]*TextValue)), true
+
 	case "Query.user":
 		if e.complexity.Query.User == nil {
 			break
@@ -1873,6 +2163,27 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Rectangle.Width(childComplexity), true
 
+	case "SerialResolution.first":
+		if e.complexity.SerialResolution.First == nil {
+			break
+		}
+
+		return e.complexity.SerialResolution.First(childComplexity), true
+
+	case "SerialResolution.second":
+		if e.complexity.SerialResolution.Second == nil {
+			break
+		}
+
+		return e.complexity.SerialResolution.Second(childComplexity), true
+
+	case "SerialResolution.third":
+		if e.complexity.SerialResolution.Third == nil {
+			break
+		}
+
+		return e.complexity.SerialResolution.Third(childComplexity), true
+
 	case "Size.height":
 		if e.complexity.Size.Height == nil {
 			break
@@ -2174,6 +2485,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputOuterInput,
 		ec.unmarshalInputRecursiveInputSlice,
 		ec.unmarshalInputSpecialInput,
+		ec.unmarshalInputTypeOmittableInput,
 		ec.unmarshalInputUpdatePtrToPtrInner,
 		ec.unmarshalInputUpdatePtrToPtrOuter,
 		ec.unmarshalInputValidInput,
@@ -2290,7 +2602,7 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
 	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
 }
 
-//go:embed "builtinscalar.graphql" "complexity.graphql" "defaults.graphql" "defer.graphql" "directive.graphql" "embedded.graphql" "enum.graphql" "fields_order.graphql" "interfaces.graphql" "issue896.graphql" "loops.graphql" "maps.graphql" "mutation_with_custom_scalar.graphql" "nulls.graphql" "panics.graphql" "primitive_objects.graphql" "ptr_to_any.graphql" "ptr_to_ptr_input.graphql" "ptr_to_slice.graphql" "scalar_context.graphql" "scalar_default.graphql" "schema.graphql" "slices.graphql" "typefallback.graphql" "useptr.graphql" "v-ok.graphql" "validtypes.graphql" "variadic.graphql" "weird_type_cases.graphql" "wrapped_type.graphql"
+//go:embed "abort.graphql" "builtinscalar.graphql" "complexity.graphql" "defaults.graphql" "defer.graphql" "deprecations.graphql" "directive.graphql" "embedded.graphql" "enum.graphql" "fields_order.graphql" "future.graphql" "interfaces.graphql" "issue896.graphql" "lazy.graphql" "loops.graphql" "maps.graphql" "mutation_with_custom_scalar.graphql" "nulls.graphql" "omittable_type.graphql" "panics.graphql" "primitive_objects.graphql" "ptr_to_any.graphql" "ptr_to_ptr_input.graphql" "ptr_to_slice.graphql" "scalar_context.graphql" "scalar_default.graphql" "scalar_text.graphql" "schema.graphql" "serial.graphql" "slices.graphql" "typefallback.graphql" "typeresolver.graphql" "useptr.graphql" "v-ok.graphql" "validtypes.graphql" "variadic.graphql" "weird_type_cases.graphql" "wrapped_type.graphql"
 var sourcesFS embed.FS
 
 func sourceData(filename string) string {
@@ -2302,20 +2614,25 @@ func sourceData(filename string) string {
 }
 
 var sources = []*ast.Source{
+	{Name: "abort.graphql", Input: sourceData("abort.graphql"), BuiltIn: false},
 	{Name: "builtinscalar.graphql", Input: sourceData("builtinscalar.graphql"), BuiltIn: false},
 	{Name: "complexity.graphql", Input: sourceData("complexity.graphql"), BuiltIn: false},
 	{Name: "defaults.graphql", Input: sourceData("defaults.graphql"), BuiltIn: false},
 	{Name: "defer.graphql", Input: sourceData("defer.graphql"), BuiltIn: false},
+	{Name: "deprecations.graphql", Input: sourceData("deprecations.graphql"), BuiltIn: false},
 	{Name: "directive.graphql", Input: sourceData("directive.graphql"), BuiltIn: false},
 	{Name: "embedded.graphql", Input: sourceData("embedded.graphql"), BuiltIn: false},
 	{Name: "enum.graphql", Input: sourceData("enum.graphql"), BuiltIn: false},
 	{Name: "fields_order.graphql", Input: sourceData("fields_order.graphql"), BuiltIn: false},
+	{Name: "future.graphql", Input: sourceData("future.graphql"), BuiltIn: false},
 	{Name: "interfaces.graphql", Input: sourceData("interfaces.graphql"), BuiltIn: false},
 	{Name: "issue896.graphql", Input: sourceData("issue896.graphql"), BuiltIn: false},
+	{Name: "lazy.graphql", Input: sourceData("lazy.graphql"), BuiltIn: false},
 	{Name: "loops.graphql", Input: sourceData("loops.graphql"), BuiltIn: false},
 	{Name: "maps.graphql", Input: sourceData("maps.graphql"), BuiltIn: false},
 	{Name: "mutation_with_custom_scalar.graphql", Input: sourceData("mutation_with_custom_scalar.graphql"), BuiltIn: false},
 	{Name: "nulls.graphql", Input: sourceData("nulls.graphql"), BuiltIn: false},
+	{Name: "omittable_type.graphql", Input: sourceData("omittable_type.graphql"), BuiltIn: false},
 	{Name: "panics.graphql", Input: sourceData("panics.graphql"), BuiltIn: false},
 	{Name: "primitive_objects.graphql", Input: sourceData("primitive_objects.graphql"), BuiltIn: false},
 	{Name: "ptr_to_any.graphql", Input: sourceData("ptr_to_any.graphql"), BuiltIn: false},
@@ -2323,9 +2640,12 @@ var sources = []*ast.Source{
 	{Name: "ptr_to_slice.graphql", Input: sourceData("ptr_to_slice.graphql"), BuiltIn: false},
 	{Name: "scalar_context.graphql", Input: sourceData("scalar_context.graphql"), BuiltIn: false},
 	{Name: "scalar_default.graphql", Input: sourceData("scalar_default.graphql"), BuiltIn: false},
+	{Name: "scalar_text.graphql", Input: sourceData("scalar_text.graphql"), BuiltIn: false},
 	{Name: "schema.graphql", Input: sourceData("schema.graphql"), BuiltIn: false},
+	{Name: "serial.graphql", Input: sourceData("serial.graphql"), BuiltIn: false},
 	{Name: "slices.graphql", Input: sourceData("slices.graphql"), BuiltIn: false},
 	{Name: "typefallback.graphql", Input: sourceData("typefallback.graphql"), BuiltIn: false},
+	{Name: "typeresolver.graphql", Input: sourceData("typeresolver.graphql"), BuiltIn: false},
 	{Name: "useptr.graphql", Input: sourceData("useptr.graphql"), BuiltIn: false},
 	{Name: "v-ok.graphql", Input: sourceData("v-ok.graphql"), BuiltIn: false},
 	{Name: "validtypes.graphql", Input: sourceData("validtypes.graphql"), BuiltIn: false},
@@ -2334,3 +2654,11 @@ var sources = []*ast.Source{
 	{Name: "wrapped_type.graphql", Input: sourceData("wrapped_type.graphql"), BuiltIn: false},
 }
 var parsedSchema = gqlparser.MustLoadSchema(sources...)
+
+// DeprecationReasons maps the schema coordinates of the fields, arguments and enum values deprecated in the
+// schema, eg. User.name, to the reason they are deprecated.
+var DeprecationReasons = map[string]string{
+	"DeprecatedStatus.LEGACY":  "No longer supported",
+	"DeprecatedStatus.RETIRED": "Use ACTIVE.",
+	"Query.deprecatedField":    "test deprecated directive",
+}
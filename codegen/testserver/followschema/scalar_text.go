@@ -0,0 +1,29 @@
+package followschema
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// TextValue is bound to a scalar only through encoding.TextMarshaler and encoding.TextUnmarshaler.
+type TextValue struct {
+	Key   string
+	Value string
+}
+
+func (v TextValue) MarshalText() ([]byte, error) {
+	if v.Key == "" {
+		return nil, errors.New("text value has no key")
+	}
+	return []byte(v.Key + "=" + v.Value), nil
+}
+
+func (v *TextValue) UnmarshalText(text []byte) error {
+	key, value, ok := strings.Cut(string(text), "=")
+	if !ok || key == "" {
+		return fmt.Errorf("%q is not a key=value pair", text)
+	}
+	v.Key, v.Value = key, value
+	return nil
+}
@@ -0,0 +1,55 @@
+package followschema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestTextMarshalerScalar(t *testing.T) {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.TextValue = func(ctx context.Context, in TextValue) (*TextValue, error) {
+		return &TextValue{Key: in.Key, Value: in.Value + "!"}, nil
+	}
+	resolvers.QueryResolver.TextValues = func(ctx context.Context, in []*TextValue) ([]*TextValue, error) {
+		return in, nil
+	}
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.POST{})
+	c := client.New(srv)
+
+	t.Run("round trips through text", func(t *testing.T) {
+		var resp struct{ TextValue string }
+		c.MustPost(`query { textValue(in: "greeting=hello") }`, &resp)
+		require.Equal(t, "greeting=hello!", resp.TextValue)
+	})
+
+	t.Run("lists with nulls", func(t *testing.T) {
+		var resp struct{ TextValues []*string }
+		c.MustPost(`query($in: [TextValue]) { textValues(in: $in) }`, &resp, client.Var("in", []any{"a=1", nil, "b=2"}))
+		require.Len(t, resp.TextValues, 3)
+		require.Equal(t, "a=1", *resp.TextValues[0])
+		require.Nil(t, resp.TextValues[1])
+		require.Equal(t, "b=2", *resp.TextValues[2])
+	})
+
+	t.Run("unmarshal errors", func(t *testing.T) {
+		err := c.Post(`query { textValue(in: "greeting") }`, &struct{ TextValue string }{})
+		require.EqualError(t, err, `[{"message":"\"greeting\" is not a key=value pair","path":["textValue","in"]}]`)
+	})
+
+	t.Run("marshal errors", func(t *testing.T) {
+		resolvers.QueryResolver.TextValues = func(ctx context.Context, in []*TextValue) ([]*TextValue, error) {
+			return []*TextValue{{}}, nil
+		}
+		var resp struct{ TextValues []*string }
+		err := c.Post(`query { textValues }`, &resp)
+		require.EqualError(t, err, `[{"message":"text value has no key","path":["textValues"]}]`)
+	})
+}
@@ -0,0 +1,61 @@
+package followschema
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestSerialResolution(t *testing.T) {
+	resolvers := &Stub{}
+
+	var active, maxActive int32
+	var order []int
+	resolve := func(n int) func(ctx context.Context, obj *SerialResolution) (int, error) {
+		return func(ctx context.Context, obj *SerialResolution) (int, error) {
+			current := atomic.AddInt32(&active, 1)
+			defer atomic.AddInt32(&active, -1)
+			for {
+				m := atomic.LoadInt32(&maxActive)
+				if current <= m || atomic.CompareAndSwapInt32(&maxActive, m, current) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			order = append(order, n)
+			return n, nil
+		}
+	}
+	resolvers.QueryResolver.SerialResolution = func(ctx context.Context) (*SerialResolution, error) {
+		return &SerialResolution{}, nil
+	}
+	resolvers.SerialResolutionResolver.First = resolve(1)
+	resolvers.SerialResolutionResolver.Second = resolve(2)
+	resolvers.SerialResolutionResolver.Third = resolve(3)
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.POST{})
+	c := client.New(srv)
+
+	var resp struct {
+		SerialResolution struct {
+			First  int
+			Second int
+			Third  int
+		}
+	}
+	c.MustPost(`query { serialResolution { third first second } }`, &resp)
+
+	require.Equal(t, 1, resp.SerialResolution.First)
+	require.Equal(t, 2, resp.SerialResolution.Second)
+	require.Equal(t, 3, resp.SerialResolution.Third)
+	require.Equal(t, int32(1), maxActive)
+	require.Equal(t, []int{3, 1, 2}, order)
+}
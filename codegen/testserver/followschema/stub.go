@@ -8,9 +8,15 @@ import (
 	introspection1 "github.com/99designs/gqlgen/codegen/testserver/followschema/introspection"
 	invalid_packagename "github.com/99designs/gqlgen/codegen/testserver/followschema/invalid-packagename"
 	"github.com/99designs/gqlgen/codegen/testserver/followschema/otherpkg"
+	"github.com/99designs/gqlgen/graphql"
 )
 
 type Stub struct {
+	AbortableStepsResolver struct {
+		First  func(ctx context.Context, obj *AbortableSteps) (*int, error)
+		Second func(ctx context.Context, obj *AbortableSteps) (*int, error)
+		Third  func(ctx context.Context, obj *AbortableSteps) (*int, error)
+	}
 	BackedByInterfaceResolver struct {
 		ID func(ctx context.Context, obj BackedByInterface) (string, error)
 	}
@@ -27,6 +33,12 @@ type Stub struct {
 	ForcedResolverResolver struct {
 		Field func(ctx context.Context, obj *ForcedResolver) (*Circle, error)
 	}
+	FutureJobResolver struct {
+		Result func(ctx context.Context, obj *FutureJob) (graphql.FutureFunc[string], error)
+	}
+	LazyFieldsResolver struct {
+		Expensive func(ctx context.Context, obj *LazyFields) (string, error)
+	}
 	ModelMethodsResolver struct {
 		ResolverField func(ctx context.Context, obj *ModelMethods) (bool, error)
 	}
@@ -69,6 +81,7 @@ type Stub struct {
 		ShapeUnion                       func(ctx context.Context) (ShapeUnion, error)
 		Autobind                         func(ctx context.Context) (*Autobind, error)
 		DeprecatedField                  func(ctx context.Context) (string, error)
+		AbortableSteps                   func(ctx context.Context) (*AbortableSteps, error)
 		Overlapping                      func(ctx context.Context) (*OverlappingFields, error)
 		DefaultParameters                func(ctx context.Context, falsyBoolean *bool, truthyBoolean *bool) (*DefaultParametersMirror, error)
 		DeferSingle                      func(ctx context.Context) (*DeferModel, error)
@@ -89,6 +102,8 @@ type Stub struct {
 		EmbeddedCase2                    func(ctx context.Context) (*EmbeddedCase2, error)
 		EmbeddedCase3                    func(ctx context.Context) (*EmbeddedCase3, error)
 		EnumInInput                      func(ctx context.Context, input *InputWithEnumValue) (EnumTest, error)
+		IntBackedEnum                    func(ctx context.Context, arg IntBackedEnum) (IntBackedEnum, error)
+		FutureJobs                       func(ctx context.Context, count int) ([]*FutureJob, error)
 		Shapes                           func(ctx context.Context) ([]Shape, error)
 		NoShape                          func(ctx context.Context) (Shape, error)
 		Node                             func(ctx context.Context) (Node, error)
@@ -97,14 +112,19 @@ type Stub struct {
 		NotAnInterface                   func(ctx context.Context) (BackedByInterface, error)
 		Dog                              func(ctx context.Context) (*Dog, error)
 		Issue896a                        func(ctx context.Context) ([]*CheckIssue896, error)
+		LazyFields                       func(ctx context.Context) (*LazyFields, error)
 		MapStringInterface               func(ctx context.Context, in map[string]interface{}) (map[string]interface{}, error)
 		MapNestedStringInterface         func(ctx context.Context, in *NestedMapInput) (map[string]interface{}, error)
+		MapDynamic                       func(ctx context.Context) (map[string]interface{}, error)
 		ErrorBubble                      func(ctx context.Context) (*Error, error)
 		ErrorBubbleList                  func(ctx context.Context) ([]*Error, error)
 		ErrorList                        func(ctx context.Context) ([]*Error, error)
+		ErrorNestedList                  func(ctx context.Context) ([][]*Error, error)
+		ErrorNestedNonNullList           func(ctx context.Context) ([][]*Error, error)
 		Errors                           func(ctx context.Context) (*Errors, error)
 		Valid                            func(ctx context.Context) (string, error)
 		Invalid                          func(ctx context.Context) (string, error)
+		InputTypeOmittable               func(ctx context.Context, arg TypeOmittableInput) (string, error)
 		Panics                           func(ctx context.Context) (*Panics, error)
 		PrimitiveObject                  func(ctx context.Context) ([]Primitive, error)
 		PrimitiveStringObject            func(ctx context.Context) ([]PrimitiveString, error)
@@ -114,9 +134,14 @@ type Stub struct {
 		StringFromContextInterface       func(ctx context.Context) (*StringFromContextInterface, error)
 		StringFromContextFunction        func(ctx context.Context) (string, error)
 		DefaultScalar                    func(ctx context.Context, arg string) (string, error)
+		DefaultCustomScalar              func(ctx context.Context, arg *Email) (*Email, error)
+		TextValue                        func(ctx context.Context, in TextValue) (*TextValue, error)
+		TextValues                       func(ctx context.Context, in []*TextValue) ([]*TextValue, error)
+		SerialResolution                 func(ctx context.Context) (*SerialResolution, error)
 		Slices                           func(ctx context.Context) (*Slices, error)
 		ScalarSlice                      func(ctx context.Context) ([]byte, error)
 		Fallback                         func(ctx context.Context, arg FallbackToStringEncoding) (FallbackToStringEncoding, error)
+		DynamicAnimals                   func(ctx context.Context) ([]DynamicAnimal, error)
 		OptionalUnion                    func(ctx context.Context) (TestUnion, error)
 		VOkCaseValue                     func(ctx context.Context) (*VOkCaseValue, error)
 		VOkCaseNil                       func(ctx context.Context) (*VOkCaseNil, error)
@@ -127,6 +152,11 @@ type Stub struct {
 		WrappedMap                       func(ctx context.Context) (WrappedMap, error)
 		WrappedSlice                     func(ctx context.Context) (WrappedSlice, error)
 	}
+	SerialResolutionResolver struct {
+		First  func(ctx context.Context, obj *SerialResolution) (int, error)
+		Second func(ctx context.Context, obj *SerialResolution) (int, error)
+		Third  func(ctx context.Context, obj *SerialResolution) (int, error)
+	}
 	SubscriptionResolver struct {
 		Updated                func(ctx context.Context) (<-chan string, error)
 		InitPayload            func(ctx context.Context) (<-chan string, error)
@@ -153,6 +183,9 @@ type Stub struct {
 	}
 }
 
+func (r *Stub) AbortableSteps() AbortableStepsResolver {
+	return &stubAbortableSteps{r}
+}
 func (r *Stub) BackedByInterface() BackedByInterfaceResolver {
 	return &stubBackedByInterface{r}
 }
@@ -165,6 +198,12 @@ func (r *Stub) Errors() ErrorsResolver {
 func (r *Stub) ForcedResolver() ForcedResolverResolver {
 	return &stubForcedResolver{r}
 }
+func (r *Stub) FutureJob() FutureJobResolver {
+	return &stubFutureJob{r}
+}
+func (r *Stub) LazyFields() LazyFieldsResolver {
+	return &stubLazyFields{r}
+}
 func (r *Stub) ModelMethods() ModelMethodsResolver {
 	return &stubModelMethods{r}
 }
@@ -189,6 +228,9 @@ func (r *Stub) PrimitiveString() PrimitiveStringResolver {
 func (r *Stub) Query() QueryResolver {
 	return &stubQuery{r}
 }
+func (r *Stub) SerialResolution() SerialResolutionResolver {
+	return &stubSerialResolution{r}
+}
 func (r *Stub) Subscription() SubscriptionResolver {
 	return &stubSubscription{r}
 }
@@ -206,6 +248,18 @@ func (r *Stub) FieldsOrderInput() FieldsOrderInputResolver {
 	return &stubFieldsOrderInput{r}
 }
 
+type stubAbortableSteps struct{ *Stub }
+
+func (r *stubAbortableSteps) First(ctx context.Context, obj *AbortableSteps) (*int, error) {
+	return r.AbortableStepsResolver.First(ctx, obj)
+}
+func (r *stubAbortableSteps) Second(ctx context.Context, obj *AbortableSteps) (*int, error) {
+	return r.AbortableStepsResolver.Second(ctx, obj)
+}
+func (r *stubAbortableSteps) Third(ctx context.Context, obj *AbortableSteps) (*int, error) {
+	return r.AbortableStepsResolver.Third(ctx, obj)
+}
+
 type stubBackedByInterface struct{ *Stub }
 
 func (r *stubBackedByInterface) ID(ctx context.Context, obj BackedByInterface) (string, error) {
@@ -242,6 +296,18 @@ func (r *stubForcedResolver) Field(ctx context.Context, obj *ForcedResolver) (*C
 	return r.ForcedResolverResolver.Field(ctx, obj)
 }
 
+type stubFutureJob struct{ *Stub }
+
+func (r *stubFutureJob) Result(ctx context.Context, obj *FutureJob) (graphql.FutureFunc[string], error) {
+	return r.FutureJobResolver.Result(ctx, obj)
+}
+
+type stubLazyFields struct{ *Stub }
+
+func (r *stubLazyFields) Expensive(ctx context.Context, obj *LazyFields) (string, error) {
+	return r.LazyFieldsResolver.Expensive(ctx, obj)
+}
+
 type stubModelMethods struct{ *Stub }
 
 func (r *stubModelMethods) ResolverField(ctx context.Context, obj *ModelMethods) (bool, error) {
@@ -346,6 +412,9 @@ func (r *stubQuery) Autobind(ctx context.Context) (*Autobind, error) {
 func (r *stubQuery) DeprecatedField(ctx context.Context) (string, error) {
 	return r.QueryResolver.DeprecatedField(ctx)
 }
+func (r *stubQuery) AbortableSteps(ctx context.Context) (*AbortableSteps, error) {
+	return r.QueryResolver.AbortableSteps(ctx)
+}
 func (r *stubQuery) Overlapping(ctx context.Context) (*OverlappingFields, error) {
 	return r.QueryResolver.Overlapping(ctx)
 }
@@ -406,6 +475,12 @@ func (r *stubQuery) EmbeddedCase3(ctx context.Context) (*EmbeddedCase3, error) {
 func (r *stubQuery) EnumInInput(ctx context.Context, input *InputWithEnumValue) (EnumTest, error) {
 	return r.QueryResolver.EnumInInput(ctx, input)
 }
+func (r *stubQuery) IntBackedEnum(ctx context.Context, arg IntBackedEnum) (IntBackedEnum, error) {
+	return r.QueryResolver.IntBackedEnum(ctx, arg)
+}
+func (r *stubQuery) FutureJobs(ctx context.Context, count int) ([]*FutureJob, error) {
+	return r.QueryResolver.FutureJobs(ctx, count)
+}
 func (r *stubQuery) Shapes(ctx context.Context) ([]Shape, error) {
 	return r.QueryResolver.Shapes(ctx)
 }
@@ -430,12 +505,18 @@ func (r *stubQuery) Dog(ctx context.Context) (*Dog, error) {
 func (r *stubQuery) Issue896a(ctx context.Context) ([]*CheckIssue896, error) {
 	return r.QueryResolver.Issue896a(ctx)
 }
+func (r *stubQuery) LazyFields(ctx context.Context) (*LazyFields, error) {
+	return r.QueryResolver.LazyFields(ctx)
+}
 func (r *stubQuery) MapStringInterface(ctx context.Context, in map[string]interface{}) (map[string]interface{}, error) {
 	return r.QueryResolver.MapStringInterface(ctx, in)
 }
 func (r *stubQuery) MapNestedStringInterface(ctx context.Context, in *NestedMapInput) (map[string]interface{}, error) {
 	return r.QueryResolver.MapNestedStringInterface(ctx, in)
 }
+func (r *stubQuery) MapDynamic(ctx context.Context) (map[string]interface{}, error) {
+	return r.QueryResolver.MapDynamic(ctx)
+}
 func (r *stubQuery) ErrorBubble(ctx context.Context) (*Error, error) {
 	return r.QueryResolver.ErrorBubble(ctx)
 }
@@ -445,6 +526,12 @@ func (r *stubQuery) ErrorBubbleList(ctx context.Context) ([]*Error, error) {
 func (r *stubQuery) ErrorList(ctx context.Context) ([]*Error, error) {
 	return r.QueryResolver.ErrorList(ctx)
 }
+func (r *stubQuery) ErrorNestedList(ctx context.Context) ([][]*Error, error) {
+	return r.QueryResolver.ErrorNestedList(ctx)
+}
+func (r *stubQuery) ErrorNestedNonNullList(ctx context.Context) ([][]*Error, error) {
+	return r.QueryResolver.ErrorNestedNonNullList(ctx)
+}
 func (r *stubQuery) Errors(ctx context.Context) (*Errors, error) {
 	return r.QueryResolver.Errors(ctx)
 }
@@ -454,6 +541,9 @@ func (r *stubQuery) Valid(ctx context.Context) (string, error) {
 func (r *stubQuery) Invalid(ctx context.Context) (string, error) {
 	return r.QueryResolver.Invalid(ctx)
 }
+func (r *stubQuery) InputTypeOmittable(ctx context.Context, arg TypeOmittableInput) (string, error) {
+	return r.QueryResolver.InputTypeOmittable(ctx, arg)
+}
 func (r *stubQuery) Panics(ctx context.Context) (*Panics, error) {
 	return r.QueryResolver.Panics(ctx)
 }
@@ -481,6 +571,18 @@ func (r *stubQuery) StringFromContextFunction(ctx context.Context) (string, erro
 func (r *stubQuery) DefaultScalar(ctx context.Context, arg string) (string, error) {
 	return r.QueryResolver.DefaultScalar(ctx, arg)
 }
+func (r *stubQuery) DefaultCustomScalar(ctx context.Context, arg *Email) (*Email, error) {
+	return r.QueryResolver.DefaultCustomScalar(ctx, arg)
+}
+func (r *stubQuery) TextValue(ctx context.Context, in TextValue) (*TextValue, error) {
+	return r.QueryResolver.TextValue(ctx, in)
+}
+func (r *stubQuery) TextValues(ctx context.Context, in []*TextValue) ([]*TextValue, error) {
+	return r.QueryResolver.TextValues(ctx, in)
+}
+func (r *stubQuery) SerialResolution(ctx context.Context) (*SerialResolution, error) {
+	return r.QueryResolver.SerialResolution(ctx)
+}
 func (r *stubQuery) Slices(ctx context.Context) (*Slices, error) {
 	return r.QueryResolver.Slices(ctx)
 }
@@ -490,6 +592,9 @@ func (r *stubQuery) ScalarSlice(ctx context.Context) ([]byte, error) {
 func (r *stubQuery) Fallback(ctx context.Context, arg FallbackToStringEncoding) (FallbackToStringEncoding, error) {
 	return r.QueryResolver.Fallback(ctx, arg)
 }
+func (r *stubQuery) DynamicAnimals(ctx context.Context) ([]DynamicAnimal, error) {
+	return r.QueryResolver.DynamicAnimals(ctx)
+}
 func (r *stubQuery) OptionalUnion(ctx context.Context) (TestUnion, error) {
 	return r.QueryResolver.OptionalUnion(ctx)
 }
@@ -518,6 +623,18 @@ func (r *stubQuery) WrappedSlice(ctx context.Context) (WrappedSlice, error) {
 	return r.QueryResolver.WrappedSlice(ctx)
 }
 
+type stubSerialResolution struct{ *Stub }
+
+func (r *stubSerialResolution) First(ctx context.Context, obj *SerialResolution) (int, error) {
+	return r.SerialResolutionResolver.First(ctx, obj)
+}
+func (r *stubSerialResolution) Second(ctx context.Context, obj *SerialResolution) (int, error) {
+	return r.SerialResolutionResolver.Second(ctx, obj)
+}
+func (r *stubSerialResolution) Third(ctx context.Context, obj *SerialResolution) (int, error) {
+	return r.SerialResolutionResolver.Third(ctx, obj)
+}
+
 type stubSubscription struct{ *Stub }
 
 func (r *stubSubscription) Updated(ctx context.Context) (<-chan string, error) {
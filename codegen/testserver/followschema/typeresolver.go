@@ -0,0 +1,14 @@
+package followschema
+
+// DynamicAnimal is only implemented by DynamicObject, which backs each of its types.
+type DynamicAnimal interface {
+	isDynamicAnimal()
+}
+
+// DynamicObject is an object whose type is only known at runtime, from its Typename.
+type DynamicObject struct {
+	Typename string
+	Name     string
+}
+
+func (*DynamicObject) isDynamicAnimal() {}
@@ -5,7 +5,6 @@ package followschema
 import (
 	"context"
 	"errors"
-	"fmt"
 	"strconv"
 	"sync/atomic"
 
@@ -136,7 +135,8 @@ func (ec *executionContext) _TestUnion(ctx context.Context, sel ast.SelectionSet
 		}
 		return ec._A(ctx, sel, obj)
 	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
+		ec.Errorf(ctx, "unexpected type %T for union TestUnion", obj)
+		return graphql.Null
 	}
 }
 
@@ -5,7 +5,6 @@ package followschema
 import (
 	"context"
 	"errors"
-	"fmt"
 	"strconv"
 	"sync/atomic"
 
@@ -1149,7 +1148,8 @@ func (ec *executionContext) _Content_Child(ctx context.Context, sel ast.Selectio
 		}
 		return ec._Content_Post(ctx, sel, obj)
 	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
+		ec.Errorf(ctx, "unexpected type %T for union Content_Child", obj)
+		return graphql.Null
 	}
 }
 
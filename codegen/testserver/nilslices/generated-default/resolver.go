@@ -0,0 +1,39 @@
+package generated
+
+// THIS CODE WILL BE UPDATED WITH SCHEMA CHANGES. PREVIOUS IMPLEMENTATION FOR SCHEMA CHANGES WILL BE KEPT IN THE COMMENT SECTION. IMPLEMENTATION FOR UNCHANGED SCHEMA WILL BE KEPT.
+
+import (
+	"context"
+)
+
+type Resolver struct{}
+
+// Strings is the resolver for the strings field.
+func (r *queryResolver) Strings(ctx context.Context) ([]string, error) {
+	panic("not implemented")
+}
+
+// Objects is the resolver for the objects field.
+func (r *queryResolver) Objects(ctx context.Context) ([]*Item, error) {
+	panic("not implemented")
+}
+
+// Required is the resolver for the required field.
+func (r *queryResolver) Required(ctx context.Context) ([]string, error) {
+	panic("not implemented")
+}
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+type queryResolver struct{ *Resolver }
+
+// !!! WARNING !!!
+// The code below was going to be deleted when updating resolvers. It has been copied here so you have
+// one last chance to move it out of harms way if you want. There are two reasons this happens:
+//  - When renaming or deleting a resolver the old code will be put in here. You can safely delete
+//    it when you're done.
+//  - You have helper methods in this file. Move them out to keep these resolver files clean.
+/*
+	type Resolver struct{}
+*/
@@ -0,0 +1,31 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+)
+
+type Stub struct {
+	QueryResolver struct {
+		Strings  func(ctx context.Context) ([]string, error)
+		Objects  func(ctx context.Context) ([]*Item, error)
+		Required func(ctx context.Context) ([]string, error)
+	}
+}
+
+func (r *Stub) Query() QueryResolver {
+	return &stubQuery{r}
+}
+
+type stubQuery struct{ *Stub }
+
+func (r *stubQuery) Strings(ctx context.Context) ([]string, error) {
+	return r.QueryResolver.Strings(ctx)
+}
+func (r *stubQuery) Objects(ctx context.Context) ([]*Item, error) {
+	return r.QueryResolver.Objects(ctx)
+}
+func (r *stubQuery) Required(ctx context.Context) ([]string, error) {
+	return r.QueryResolver.Required(ctx)
+}
@@ -0,0 +1,101 @@
+//go:generate go run ../../../testdata/gqlgen.go -config gqlgen_default.yml -stub generated-default/stub.go
+//go:generate go run ../../../testdata/gqlgen.go -config gqlgen_empty.yml -stub generated-empty/stub.go
+
+package nilslices
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	gendefault "github.com/99designs/gqlgen/codegen/testserver/nilslices/generated-default"
+	genempty "github.com/99designs/gqlgen/codegen/testserver/nilslices/generated-empty"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+const query = `{ strings objects { id } required }`
+
+func TestNilSlices(t *testing.T) {
+	cases := []struct {
+		name   string
+		schema func(empty bool) graphql.ExecutableSchema
+		nil    string
+		empty  string
+	}{
+		{
+			name: "default",
+			schema: func(empty bool) graphql.ExecutableSchema {
+				resolvers := &gendefault.Stub{}
+				resolvers.QueryResolver.Strings = func(ctx context.Context) ([]string, error) {
+					return slice[string](empty), nil
+				}
+				resolvers.QueryResolver.Objects = func(ctx context.Context) ([]*gendefault.Item, error) {
+					return slice[*gendefault.Item](empty), nil
+				}
+				resolvers.QueryResolver.Required = func(ctx context.Context) ([]string, error) {
+					return slice[string](empty), nil
+				}
+				return gendefault.NewExecutableSchema(gendefault.Config{Resolvers: resolvers})
+			},
+			nil:   `{"strings":null,"objects":null,"required":[]}`,
+			empty: `{"strings":[],"objects":[],"required":[]}`,
+		},
+		{
+			name: "nil_slices_as_empty_lists",
+			schema: func(empty bool) graphql.ExecutableSchema {
+				resolvers := &genempty.Stub{}
+				resolvers.QueryResolver.Strings = func(ctx context.Context) ([]string, error) {
+					return slice[string](empty), nil
+				}
+				resolvers.QueryResolver.Objects = func(ctx context.Context) ([]*genempty.Item, error) {
+					return slice[*genempty.Item](empty), nil
+				}
+				resolvers.QueryResolver.Required = func(ctx context.Context) ([]string, error) {
+					return slice[string](empty), nil
+				}
+				return genempty.NewExecutableSchema(genempty.Config{Resolvers: resolvers})
+			},
+			nil:   `{"strings":[],"objects":[],"required":[]}`,
+			empty: `{"strings":[],"objects":[],"required":[]}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Run("nil slice", func(t *testing.T) {
+				require.JSONEq(t, tc.nil, post(t, tc.schema(false)))
+			})
+
+			t.Run("empty slice", func(t *testing.T) {
+				require.JSONEq(t, tc.empty, post(t, tc.schema(true)))
+			})
+		})
+	}
+}
+
+func slice[T any](empty bool) []T {
+	if empty {
+		return []T{}
+	}
+	return nil
+}
+
+func post(t *testing.T, es graphql.ExecutableSchema) string {
+	t.Helper()
+
+	srv := handler.New(es)
+	srv.AddTransport(transport.POST{})
+	c := client.New(srv)
+
+	var resp map[string]any
+	c.MustPost(query, &resp)
+
+	b, err := json.Marshal(resp)
+	require.NoError(t, err)
+	return string(b)
+}
@@ -0,0 +1,15 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package model
+
+type Mutation struct {
+}
+
+type Query struct {
+}
+
+type User struct {
+	ID      string  `json:"id"`
+	Name    string  `json:"name"`
+	Friends []*User `json:"friends"`
+}
@@ -0,0 +1,94 @@
+//go:generate go run ../../../testdata/gqlgen.go -config gqlgen.yml
+
+package resolverinterfaces
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/codegen/testserver/resolverinterfaces/generated"
+	"github.com/99designs/gqlgen/codegen/testserver/resolverinterfaces/model"
+	"github.com/99designs/gqlgen/codegen/testserver/resolverinterfaces/resolvers"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+type resolver struct {
+	users map[string]*model.User
+}
+
+var _ resolvers.ResolverRoot = &resolver{}
+
+func (r *resolver) Mutation() resolvers.MutationResolver { return r }
+func (r *resolver) Query() resolvers.QueryResolver       { return &queryResolver{r} }
+func (r *resolver) User() resolvers.UserResolver         { return r }
+
+func (r *resolver) Rename(ctx context.Context, id, name string) (*model.User, error) {
+	r.users[id].Name = name
+	return r.users[id], nil
+}
+
+func (r *resolver) Friends(ctx context.Context, obj *model.User) ([]*model.User, error) {
+	var friends []*model.User
+	for id, user := range r.users {
+		if id != obj.ID {
+			friends = append(friends, user)
+		}
+	}
+	return friends, nil
+}
+
+type queryResolver struct{ *resolver }
+
+func (r *queryResolver) User(ctx context.Context, id string) (*model.User, error) {
+	return r.users[id], nil
+}
+
+func TestResolverInterfaces(t *testing.T) {
+	t.Run("interfaces package does not depend on exec", func(t *testing.T) {
+		pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedName | packages.NeedImports | packages.NeedDeps},
+			"github.com/99designs/gqlgen/codegen/testserver/resolverinterfaces/resolvers")
+		require.NoError(t, err)
+		require.Len(t, pkgs, 1)
+		require.Empty(t, pkgs[0].Errors)
+
+		var deps []string
+		packages.Visit(pkgs, nil, func(pkg *packages.Package) {
+			deps = append(deps, pkg.PkgPath)
+		})
+		require.Contains(t, deps, "github.com/99designs/gqlgen/codegen/testserver/resolverinterfaces/model")
+		require.NotContains(t, deps, "github.com/99designs/gqlgen/codegen/testserver/resolverinterfaces/generated")
+		require.NotContains(t, deps, "github.com/99designs/gqlgen/graphql/executor")
+	})
+
+	t.Run("exec accepts resolvers implementing the interfaces", func(t *testing.T) {
+		r := &resolver{users: map[string]*model.User{
+			"1": {ID: "1", Name: "Alice"},
+			"2": {ID: "2", Name: "Bob"},
+		}}
+		srv := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: r}))
+		srv.AddTransport(transport.POST{})
+		c := client.New(srv)
+
+		var resp struct {
+			Rename struct{ Name string }
+		}
+		c.MustPost(`mutation { rename(id: "1", name: "Carol") { name } }`, &resp)
+		require.Equal(t, "Carol", resp.Rename.Name)
+
+		var query struct {
+			User struct {
+				Name    string
+				Friends []struct{ Name string }
+			}
+		}
+		c.MustPost(`{ user(id: "2") { name friends { name } } }`, &query)
+		require.Equal(t, "Bob", query.User.Name)
+		require.Len(t, query.User.Friends, 1)
+		require.Equal(t, "Carol", query.User.Friends[0].Name)
+	})
+}
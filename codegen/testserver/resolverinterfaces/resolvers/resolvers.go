@@ -0,0 +1,25 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package resolvers
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/codegen/testserver/resolverinterfaces/model"
+)
+
+type ResolverRoot interface {
+	Mutation() MutationResolver
+	Query() QueryResolver
+	User() UserResolver
+}
+
+type MutationResolver interface {
+	Rename(ctx context.Context, id string, name string) (*model.User, error)
+}
+type QueryResolver interface {
+	User(ctx context.Context, id string) (*model.User, error)
+}
+type UserResolver interface {
+	Friends(ctx context.Context, obj *model.User) ([]*model.User, error)
+}
@@ -0,0 +1,68 @@
+package singlefile
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestAbortOperation(t *testing.T) {
+	var killSwitch atomic.Bool
+	var resolved []string
+
+	resolvers := &Stub{}
+	resolvers.QueryResolver.AbortableSteps = func(ctx context.Context) (*AbortableSteps, error) {
+		return &AbortableSteps{}, nil
+	}
+	step := func(name string, n int) func(ctx context.Context, obj *AbortableSteps) (*int, error) {
+		return func(ctx context.Context, obj *AbortableSteps) (*int, error) {
+			resolved = append(resolved, name)
+			if name == "first" {
+				killSwitch.Store(true)
+			}
+			return &n, nil
+		}
+	}
+	resolvers.AbortableStepsResolver.First = step("first", 1)
+	resolvers.AbortableStepsResolver.Second = step("second", 2)
+	resolvers.AbortableStepsResolver.Third = step("third", 3)
+
+	errKilled := errors.New("kill switch flipped")
+	var cause error
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.POST{})
+	srv.AroundFields(func(ctx context.Context, next graphql.Resolver) (res any, err error) {
+		if killSwitch.Load() {
+			graphql.AbortOperation(ctx, errKilled)
+			cause = context.Cause(ctx)
+			return nil, nil
+		}
+		return next(ctx)
+	})
+	c := client.New(srv)
+
+	var resp struct {
+		AbortableSteps struct {
+			First  *int
+			Second *int
+			Third  *int
+		}
+	}
+	err := c.Post(`query { abortableSteps { first second third } }`, &resp)
+
+	require.EqualError(t, err, `[{"message":"kill switch flipped","path":["abortableSteps","second"]}]`)
+	require.Equal(t, 1, *resp.AbortableSteps.First)
+	require.Nil(t, resp.AbortableSteps.Second)
+	require.Nil(t, resp.AbortableSteps.Third)
+	require.Equal(t, []string{"first"}, resolved)
+	require.ErrorIs(t, cause, errKilled)
+}
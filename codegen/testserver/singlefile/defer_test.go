@@ -110,6 +110,7 @@ func TestDefer(t *testing.T) {
 	cases := []struct {
 		name                      string
 		query                     string
+		options                   []client.Option
 		expectedInitialResponse   any
 		expectedDeferredResponses []deferredData
 	}{
@@ -301,6 +302,81 @@ fragment DeferFragment on DeferModel {
 				},
 			},
 		},
+		{
+			name: "defer single with label from variable",
+			query: `query testDefer($label: String) {
+	deferSingle {
+		id
+		name
+		... @defer(label: $label) {
+			values
+		}
+	}
+}`,
+			options: []client.Option{client.Var("label", "variable label")},
+			expectedInitialResponse: response[struct {
+				DeferSingle deferModel
+			}]{
+				Data: struct {
+					DeferSingle deferModel
+				}{
+					DeferSingle: deferModel{
+						Id:     "1",
+						Name:   "Defer test 1",
+						Values: nil,
+					},
+				},
+				HasNext: true,
+			},
+			expectedDeferredResponses: []deferredData{
+				{
+					Data: struct {
+						Values []string `json:"values"`
+					}{
+						Values: []string{"test defer 1", "test defer 2", "test defer 3"},
+					},
+					Label: "variable label",
+					Path:  []any{"deferSingle"},
+				},
+			},
+		},
+		{
+			name: "defer single path uses the field alias",
+			query: `query testDefer {
+	aliased: deferSingle {
+		id
+		name
+		... @defer(label: "test label") {
+			values
+		}
+	}
+}`,
+			expectedInitialResponse: response[struct {
+				Aliased deferModel
+			}]{
+				Data: struct {
+					Aliased deferModel
+				}{
+					Aliased: deferModel{
+						Id:     "1",
+						Name:   "Defer test 1",
+						Values: nil,
+					},
+				},
+				HasNext: true,
+			},
+			expectedDeferredResponses: []deferredData{
+				{
+					Data: struct {
+						Values []string `json:"values"`
+					}{
+						Values: []string{"test defer 1", "test defer 2", "test defer 3"},
+					},
+					Label: "test label",
+					Path:  []any{"aliased"},
+				},
+			},
+		},
 		{
 			name: "defer single when if arg is true",
 			query: `query testDefer {
@@ -474,7 +550,7 @@ fragment DeferFragment on DeferModel {
 			resE := reflect.New(resT).Elem()
 			resp := resE.Interface()
 
-			read := c.SSE(context.Background(), tc.query)
+			read := c.SSE(context.Background(), tc.query, tc.options...)
 			require.NoError(t, read.Next(&resp))
 			assert.Equal(t, tc.expectedInitialResponse, resp)
 
@@ -513,7 +589,7 @@ fragment DeferFragment on DeferModel {
 			resE := reflect.New(resT).Elem()
 			resp := resE.Interface()
 
-			read := c.IncrementalHTTP(context.Background(), tc.query)
+			read := c.IncrementalHTTP(context.Background(), tc.query, tc.options...)
 			require.NoError(t, read.Next(&resp))
 			assert.Equal(t, tc.expectedInitialResponse, resp)
 
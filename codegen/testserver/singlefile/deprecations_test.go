@@ -0,0 +1,15 @@
+package singlefile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeprecationReasons(t *testing.T) {
+	require.Equal(t, map[string]string{
+		"Query.deprecatedField":    "test deprecated directive",
+		"DeprecatedStatus.RETIRED": "Use ACTIVE.",
+		"DeprecatedStatus.LEGACY":  "No longer supported",
+	}, DeprecationReasons)
+}
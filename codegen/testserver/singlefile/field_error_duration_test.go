@@ -0,0 +1,79 @@
+package singlefile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestFieldErrorDuration(t *testing.T) {
+	var resolverErr error
+	resolvers := &Stub{}
+	resolvers.QueryResolver.Valid = func(ctx context.Context) (string, error) {
+		return "", resolverErr
+	}
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.FieldErrorDuration{})
+	c := client.New(srv)
+
+	type responseError struct {
+		Message    string
+		Path       []string
+		Extensions map[string]any
+	}
+	request := func(t *testing.T, err error) []responseError {
+		resolverErr = err
+		resp, postErr := c.RawPost(`query { valid }`)
+		require.NoError(t, postErr)
+		var errs []responseError
+		require.NoError(t, json.Unmarshal(resp.Errors, &errs))
+		for _, err := range errs {
+			require.Equal(t, []string{"valid"}, err.Path)
+			require.IsType(t, float64(0), err.Extensions["durationMs"], err.Message)
+			delete(err.Extensions, "durationMs")
+		}
+		return errs
+	}
+
+	t.Run("adds the duration to the error", func(t *testing.T) {
+		require.Equal(t, []responseError{
+			{Message: "failed", Path: []string{"valid"}, Extensions: map[string]any{}},
+		}, request(t, errors.New("failed")))
+	})
+
+	t.Run("adds the duration to each joined error", func(t *testing.T) {
+		errs := request(t, errors.Join(gqlerror.Errorf("a"), errors.New("b")))
+		require.Equal(t, []responseError{
+			{Message: "a", Path: []string{"valid"}, Extensions: map[string]any{}},
+			{Message: "b", Path: []string{"valid"}, Extensions: map[string]any{}},
+		}, errs)
+	})
+
+	t.Run("keeps wrapping errors", func(t *testing.T) {
+		errs := request(t, graphql.WithCategory(gqlerror.Errorf("unauthorized"), graphql.ErrorCategoryAuth))
+		require.Equal(t, []responseError{
+			{Message: "unauthorized", Path: []string{"valid"}, Extensions: map[string]any{"category": graphql.ErrorCategoryAuth}},
+		}, errs)
+	})
+
+	t.Run("does not modify shared errors", func(t *testing.T) {
+		shared := &gqlerror.Error{Message: "shared", Extensions: map[string]any{"code": "SHARED"}}
+		errs := request(t, shared)
+		require.Equal(t, []responseError{
+			{Message: "shared", Path: []string{"valid"}, Extensions: map[string]any{"code": "SHARED"}},
+		}, errs)
+		require.Equal(t, map[string]any{"code": "SHARED"}, shared.Extensions)
+	})
+}
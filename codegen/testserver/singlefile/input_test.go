@@ -71,6 +71,107 @@ func TestInput(t *testing.T) {
 		require.NoError(t, err)
 		require.True(t, resp.Coerced)
 	})
+
+	t.Run("coerce single variable value to slice", func(t *testing.T) {
+		var got []string
+		check := func(ctx context.Context, arg []string) (b bool, e error) {
+			got = arg
+			return true, nil
+		}
+		resolvers.QueryResolver.InputSlice = check
+		resolvers.QueryResolver.InputNullableSlice = check
+
+		var resp struct {
+			InputSlice         bool
+			InputNullableSlice bool
+		}
+		err := c.Post(`query($arg: [String!]!) { inputSlice(arg: $arg) }`, &resp, client.Var("arg", "x"))
+		require.NoError(t, err)
+		require.Equal(t, []string{"x"}, got)
+
+		got = nil
+		err = c.Post(`query($arg: [String!]) { inputNullableSlice(arg: $arg) }`, &resp, client.Var("arg", "x"))
+		require.NoError(t, err)
+		require.Equal(t, []string{"x"}, got)
+	})
+
+	t.Run("typed struct variables", func(t *testing.T) {
+		var got *RecursiveInputSlice
+		resolvers.QueryResolver.Recursive = func(ctx context.Context, input *RecursiveInputSlice) (*bool, error) {
+			got = input
+			return nil, nil
+		}
+
+		type recursiveInput struct {
+			Self []recursiveInput `json:"self,omitempty"`
+		}
+		var vars struct {
+			Input recursiveInput `json:"input"`
+		}
+		vars.Input.Self = []recursiveInput{{Self: []recursiveInput{{}}}}
+
+		var resp struct {
+			Recursive *bool
+		}
+		err := c.Post(`query($input: RecursiveInputSlice) { recursive(input: $input) }`, &resp, client.Vars(vars))
+		require.NoError(t, err)
+		require.Equal(t, &RecursiveInputSlice{Self: []RecursiveInputSlice{{Self: []RecursiveInputSlice{{}}}}}, got)
+	})
+
+	t.Run("coerce single input object field value to slice", func(t *testing.T) {
+		var got *RecursiveInputSlice
+		resolvers.QueryResolver.Recursive = func(ctx context.Context, input *RecursiveInputSlice) (*bool, error) {
+			got = input
+			return nil, nil
+		}
+
+		var resp struct {
+			Recursive *bool
+		}
+		err := c.Post(`query { recursive(input: { self: { self: [] } }) }`, &resp)
+		require.NoError(t, err)
+		require.Equal(t, &RecursiveInputSlice{Self: []RecursiveInputSlice{{Self: []RecursiveInputSlice{}}}}, got)
+	})
+}
+
+func TestInputUnknownFields(t *testing.T) {
+	resolvers := &Stub{}
+	var got [][]*OuterInput
+	resolvers.QueryResolver.NestedInputs = func(ctx context.Context, input [][]*OuterInput) (*bool, error) {
+		got = input
+		return nil, nil
+	}
+
+	query := `query($input: [[OuterInput]]) { nestedInputs(input: $input) }`
+	input := func() client.Option {
+		return client.Var("input", []any{[]any{map[string]any{"inner": map[string]any{"id": 1, "extra": 2}}}})
+	}
+
+	t.Run("rejected by default", func(t *testing.T) {
+		srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+		srv.AddTransport(transport.POST{})
+		c := client.New(srv)
+
+		var resp struct {
+			NestedInputs *bool
+		}
+		err := c.Post(query, &resp, input())
+		require.EqualError(t, err, `http 422: {"errors":[{"message":"unknown field \"extra\" on input type \"InnerInput\"","path":["variable","input",0,0,"inner","extra"],"extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`)
+	})
+
+	t.Run("ignored when configured", func(t *testing.T) {
+		srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+		srv.AddTransport(transport.POST{})
+		srv.SetIgnoreUnknownInputFields(true)
+		c := client.New(srv)
+
+		var resp struct {
+			NestedInputs *bool
+		}
+		err := c.Post(query, &resp, input())
+		require.NoError(t, err)
+		require.Equal(t, [][]*OuterInput{{{Inner: &InnerInput{ID: 1}}}}, got)
+	})
 }
 
 func TestInputOmittable(t *testing.T) {
@@ -0,0 +1,54 @@
+package singlefile
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestLazyFields(t *testing.T) {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.LazyFields = func(ctx context.Context) (*LazyFields, error) {
+		return &LazyFields{ID: "1"}, nil
+	}
+	var calls atomic.Int32
+	resolvers.LazyFieldsResolver.Expensive = func(ctx context.Context, obj *LazyFields) (string, error) {
+		calls.Add(1)
+		return "expensive " + obj.ID, nil
+	}
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.POST{})
+	c := client.New(srv)
+
+	t.Run("not selected", func(t *testing.T) {
+		calls.Store(0)
+		var resp struct {
+			LazyFields struct {
+				ID string
+			}
+		}
+		c.MustPost(`{ lazyFields { id } }`, &resp)
+		require.Equal(t, "1", resp.LazyFields.ID)
+		require.Zero(t, calls.Load())
+	})
+
+	t.Run("selected", func(t *testing.T) {
+		calls.Store(0)
+		var resp struct {
+			LazyFields struct {
+				ID        string
+				Expensive string
+			}
+		}
+		c.MustPost(`{ lazyFields { id expensive } }`, &resp)
+		require.Equal(t, "expensive 1", resp.LazyFields.Expensive)
+		require.EqualValues(t, 1, calls.Load())
+	})
+}
@@ -47,6 +47,12 @@ type AbIt struct {
 	ID string `json:"id"`
 }
 
+type AbortableSteps struct {
+	First  *int `json:"first,omitempty"`
+	Second *int `json:"second,omitempty"`
+	Third  *int `json:"third,omitempty"`
+}
+
 type B struct {
 	ID string `json:"id"`
 }
@@ -118,6 +124,11 @@ type FieldsOrderPayload struct {
 	FirstFieldValue *string `json:"firstFieldValue,omitempty"`
 }
 
+type FutureJob struct {
+	ID     string `json:"id"`
+	Result string `json:"result"`
+}
+
 type Horse struct {
 	Species    string `json:"species"`
 	Size       *Size  `json:"size"`
@@ -154,6 +165,11 @@ type InputWithEnumValue struct {
 	Enum EnumTest `json:"enum"`
 }
 
+type LazyFields struct {
+	ID        string `json:"id"`
+	Expensive string `json:"expensive"`
+}
+
 type LoopA struct {
 	B *LoopB `json:"b"`
 }
@@ -212,6 +228,12 @@ type Pet struct {
 type Query struct {
 }
 
+type SerialResolution struct {
+	First  int `json:"first"`
+	Second int `json:"second"`
+	Third  int `json:"third"`
+}
+
 type Size struct {
 	Height int `json:"height"`
 	Weight int `json:"weight"`
@@ -231,6 +253,12 @@ type SpecialInput struct {
 type Subscription struct {
 }
 
+type TypeOmittableInput struct {
+	Name     graphql.Omittable[*string] `json:"name,omitempty"`
+	Count    graphql.Omittable[*int]    `json:"count,omitempty"`
+	Required string                     `json:"required"`
+}
+
 type User struct {
 	ID      int        `json:"id"`
 	Friends []*User    `json:"friends"`
@@ -292,6 +320,63 @@ type IIt struct {
 	ID string `json:"id"`
 }
 
+type DeprecatedStatus string
+
+const (
+	DeprecatedStatusActive  DeprecatedStatus = "ACTIVE"
+	DeprecatedStatusRetired DeprecatedStatus = "RETIRED"
+	DeprecatedStatusLegacy  DeprecatedStatus = "LEGACY"
+)
+
+var AllDeprecatedStatus = []DeprecatedStatus{
+	DeprecatedStatusActive,
+	DeprecatedStatusRetired,
+	DeprecatedStatusLegacy,
+}
+
+func (e DeprecatedStatus) IsValid() bool {
+	switch e {
+	case DeprecatedStatusActive, DeprecatedStatusRetired, DeprecatedStatusLegacy:
+		return true
+	}
+	return false
+}
+
+func (e DeprecatedStatus) String() string {
+	return string(e)
+}
+
+func (e *DeprecatedStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DeprecatedStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DeprecatedStatus", str)
+	}
+	return nil
+}
+
+func (e DeprecatedStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DeprecatedStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DeprecatedStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type EnumTest string
 
 const (
@@ -347,6 +432,77 @@ func (e EnumTest) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type IntBackedEnum int
+
+const (
+	IntBackedEnumLow    IntBackedEnum = 1
+	IntBackedEnumMedium IntBackedEnum = 5
+	IntBackedEnumHigh   IntBackedEnum = 10
+)
+
+var AllIntBackedEnum = []IntBackedEnum{
+	IntBackedEnumLow,
+	IntBackedEnumMedium,
+	IntBackedEnumHigh,
+}
+
+func (e IntBackedEnum) IsValid() bool {
+	switch e {
+	case IntBackedEnumLow, IntBackedEnumMedium, IntBackedEnumHigh:
+		return true
+	}
+	return false
+}
+
+func (e IntBackedEnum) String() string {
+	switch e {
+	case IntBackedEnumLow:
+		return "LOW"
+	case IntBackedEnumMedium:
+		return "MEDIUM"
+	case IntBackedEnumHigh:
+		return "HIGH"
+	}
+	return strconv.Itoa(int(e))
+}
+
+func (e *IntBackedEnum) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	switch str {
+	case "LOW":
+		*e = IntBackedEnumLow
+	case "MEDIUM":
+		*e = IntBackedEnumMedium
+	case "HIGH":
+		*e = IntBackedEnumHigh
+	default:
+		return fmt.Errorf("%s is not a valid IntBackedEnum", str)
+	}
+	return nil
+}
+
+func (e IntBackedEnum) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *IntBackedEnum) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e IntBackedEnum) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type Status string
 
 const (
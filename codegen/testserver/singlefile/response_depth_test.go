@@ -0,0 +1,75 @@
+package singlefile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestResponseDepthLimit(t *testing.T) {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.Node = func(ctx context.Context) (Node, error) {
+		node := &ConcreteNodeA{ID: "1"}
+		node.child = node
+		return node, nil
+	}
+	var anyValue any
+	resolvers.QueryResolver.PtrToAnyContainer = func(ctx context.Context) (*PtrToAnyContainer, error) {
+		return &PtrToAnyContainer{PtrToAny: &anyValue}, nil
+	}
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.ResponseDepthLimit{Limit: 3})
+	c := client.New(srv)
+
+	t.Run("within the limit", func(t *testing.T) {
+		var resp struct {
+			Node struct {
+				Child struct {
+					ID string
+				}
+			}
+		}
+		c.MustPost(`{ node { child { id } } }`, &resp)
+		require.Equal(t, "1", resp.Node.Child.ID)
+	})
+
+	t.Run("past the limit", func(t *testing.T) {
+		var resp any
+		err := c.Post(`{ node { child { child { child { child { id } } } } } }`, &resp)
+		require.EqualError(t, err, `[{"message":"response exceeds the maximum depth of 3","path":["node","child","child","child"]}]`)
+	})
+	t.Run("scalar value within the limit", func(t *testing.T) {
+		anyValue = map[string]any{"a": 1}
+
+		var resp struct {
+			PtrToAnyContainer struct {
+				PtrToAny any
+			}
+		}
+		c.MustPost(`{ ptrToAnyContainer { ptrToAny } }`, &resp)
+		require.Equal(t, map[string]any{"a": float64(1)}, resp.PtrToAnyContainer.PtrToAny)
+	})
+
+	t.Run("cyclic scalar value", func(t *testing.T) {
+		cyclic := map[string]any{}
+		cyclic["self"] = cyclic
+		anyValue = cyclic
+
+		var resp struct {
+			PtrToAnyContainer struct {
+				PtrToAny any
+			}
+		}
+		err := c.Post(`{ ptrToAnyContainer { ptrToAny } }`, &resp)
+		require.EqualError(t, err, `[{"message":"response exceeds the maximum depth of 3","path":["ptrToAnyContainer","ptrToAny"]}]`)
+		require.Nil(t, resp.PtrToAnyContainer.PtrToAny)
+	})
+}
@@ -33,3 +33,39 @@ func TestDefaultScalarImplementation(t *testing.T) {
 		require.Equal(t, "default", resp.DefaultScalar)
 	})
 }
+
+func TestDefaultCustomScalarArgument(t *testing.T) {
+	resolvers := &Stub{}
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.POST{})
+	c := client.New(srv)
+
+	resolvers.QueryResolver.DefaultCustomScalar = func(ctx context.Context, arg *Email) (*Email, error) {
+		return arg, nil
+	}
+
+	t.Run("with arg value", func(t *testing.T) {
+		var resp struct{ DefaultCustomScalar string }
+		c.MustPost(`query { defaultCustomScalar(arg: "user@example.com") }`, &resp)
+		require.Equal(t, "user@example.com", resp.DefaultCustomScalar)
+	})
+
+	t.Run("with default value", func(t *testing.T) {
+		var resp struct{ DefaultCustomScalar string }
+		c.MustPost(`query { defaultCustomScalar }`, &resp)
+		require.Equal(t, "default@example.com", resp.DefaultCustomScalar)
+	})
+
+	t.Run("with omitted variable", func(t *testing.T) {
+		var resp struct{ DefaultCustomScalar string }
+		c.MustPost(`query($arg: Email) { defaultCustomScalar(arg: $arg) }`, &resp)
+		require.Equal(t, "default@example.com", resp.DefaultCustomScalar)
+	})
+
+	t.Run("arg value is unmarshaled by the scalar", func(t *testing.T) {
+		var resp struct{ DefaultCustomScalar string }
+		err := c.Post(`query { defaultCustomScalar(arg: "invalid") }`, &resp)
+		require.EqualError(t, err, `[{"message":"invalid email format","path":["defaultCustomScalar","arg"]}]`)
+	})
+}
@@ -0,0 +1,73 @@
+package singlefile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestTypeResolver(t *testing.T) {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.DynamicAnimals = func(ctx context.Context) ([]DynamicAnimal, error) {
+		return []DynamicAnimal{
+			&DynamicObject{Typename: "DynamicCat", Name: "Tom"},
+			&DynamicObject{Typename: "DynamicDog", Name: "Rex"},
+		}, nil
+	}
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.AddTransport(transport.POST{})
+	c := client.New(srv)
+
+	query := `query { dynamicAnimals { __typename ... on DynamicCat { name } ... on DynamicDog { name } } }`
+
+	t.Run("resolves the types with the registered type resolver", func(t *testing.T) {
+		graphql.RegisterTypeResolver("DynamicAnimal", func(obj any) string {
+			return obj.(*DynamicObject).Typename
+		})
+		defer graphql.RegisterTypeResolver("DynamicAnimal", nil)
+
+		var resp struct {
+			DynamicAnimals []struct {
+				Typename string `json:"__typename"`
+				Name     string
+			}
+		}
+		c.MustPost(query, &resp)
+
+		require.Len(t, resp.DynamicAnimals, 2)
+		require.Equal(t, "DynamicCat", resp.DynamicAnimals[0].Typename)
+		require.Equal(t, "Tom", resp.DynamicAnimals[0].Name)
+		require.Equal(t, "DynamicDog", resp.DynamicAnimals[1].Typename)
+		require.Equal(t, "Rex", resp.DynamicAnimals[1].Name)
+	})
+
+	t.Run("a panicking type resolver is an error at its element", func(t *testing.T) {
+		graphql.RegisterTypeResolver("DynamicAnimal", func(obj any) string {
+			if obj.(*DynamicObject).Name == "Rex" {
+				panic("unknown animal")
+			}
+			return obj.(*DynamicObject).Typename
+		})
+		defer graphql.RegisterTypeResolver("DynamicAnimal", nil)
+
+		var resp struct {
+			DynamicAnimals []struct{ Name string }
+		}
+		err := c.Post(query, &resp)
+		require.EqualError(t, err, `[{"message":"internal system error","path":["dynamicAnimals",1]}]`)
+		require.Nil(t, resp.DynamicAnimals)
+	})
+
+	t.Run("fails without a registered type resolver", func(t *testing.T) {
+		var resp any
+		err := c.Post(query, &resp)
+		require.ErrorContains(t, err, "no type resolver registered for DynamicAnimal")
+	})
+}
@@ -0,0 +1,126 @@
+//go:generate go run ../../../testdata/gqlgen.go -config gqlgen.yml
+
+package subscriptionerrors
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/codegen/testserver/subscriptionerrors/generated"
+	"github.com/99designs/gqlgen/codegen/testserver/subscriptionerrors/model"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+type resolver struct{}
+
+var _ generated.ResolverRoot = resolver{}
+
+func (r resolver) Query() generated.QueryResolver               { return r }
+func (r resolver) Subscription() generated.SubscriptionResolver { return r }
+
+func (resolver) Version(ctx context.Context) (string, error) {
+	return "1.0.0", nil
+}
+
+func (resolver) Messages(ctx context.Context, failAfter int, buffered bool) (<-chan *model.Message, <-chan error, error) {
+	if failAfter < 0 {
+		return nil, nil, errors.New("failAfter must not be negative")
+	}
+
+	messages := make(chan *model.Message)
+	errs := make(chan error)
+	if buffered {
+		messages = make(chan *model.Message, failAfter)
+		errs = make(chan error, 1)
+	}
+	go func() {
+		defer close(messages)
+		for i := 1; i <= failAfter; i++ {
+			select {
+			case messages <- &model.Message{Text: "message " + strconv.Itoa(i)}:
+			case <-ctx.Done():
+				return
+			}
+		}
+		select {
+		case errs <- errors.New("stream failed"):
+		case <-ctx.Done():
+		}
+	}()
+	return messages, errs, nil
+}
+
+func TestSubscriptionErrorChannels(t *testing.T) {
+	srv := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: resolver{}}))
+	srv.AddTransport(transport.Websocket{})
+	srv.AddTransport(transport.SSE{})
+	srv.AddTransport(transport.POST{})
+	c := client.New(srv)
+
+	type response struct {
+		Messages struct {
+			Text string
+		}
+	}
+
+	t.Run("websocket sends the error as an error message", func(t *testing.T) {
+		sub := c.Websocket(`subscription { messages(failAfter: 2) { text } }`)
+		defer sub.Close()
+
+		for i := 1; i <= 2; i++ {
+			var resp response
+			require.NoError(t, sub.Next(&resp))
+			require.Equal(t, "message "+strconv.Itoa(i), resp.Messages.Text)
+		}
+
+		var resp response
+		err := sub.Next(&resp)
+		require.EqualError(t, err, `[{"message":"stream failed","path":["messages"]}]`)
+	})
+
+	t.Run("results buffered before the error are sent first", func(t *testing.T) {
+		for range 20 {
+			sub := c.Websocket(`subscription { messages(failAfter: 3, buffered: true) { text } }`)
+
+			for i := 1; i <= 3; i++ {
+				var resp response
+				require.NoError(t, sub.Next(&resp))
+				require.Equal(t, "message "+strconv.Itoa(i), resp.Messages.Text)
+			}
+
+			var resp response
+			err := sub.Next(&resp)
+			require.EqualError(t, err, `[{"message":"stream failed","path":["messages"]}]`)
+			sub.Close()
+		}
+	})
+
+	t.Run("errors returned by the resolver are sent as usual", func(t *testing.T) {
+		sub := c.Websocket(`subscription { messages(failAfter: -1) { text } }`)
+		defer sub.Close()
+
+		var resp response
+		err := sub.Next(&resp)
+		require.EqualError(t, err, `[{"message":"failAfter must not be negative","path":["messages"]}]`)
+	})
+
+	t.Run("transports without error messages add the error to the response", func(t *testing.T) {
+		sub := c.SSE(context.Background(), `subscription { messages(failAfter: 1) { text } }`)
+		defer sub.Close()
+
+		var resp client.SSEResponse
+		require.NoError(t, sub.Next(&resp))
+		require.Equal(t, map[string]any{"messages": map[string]any{"text": "message 1"}}, resp.Data)
+
+		err := sub.Next(&resp)
+		require.EqualError(t, err, `[{"message":"stream failed","path":["messages"]}]`)
+
+		require.NoError(t, sub.Next(&resp))
+	})
+}
@@ -0,0 +1,53 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrOperationAborted is the error added to the response by AbortOperation when it is not given one.
+var ErrOperationAborted = errors.New("operation aborted")
+
+type operationAbort struct {
+	once    sync.Once
+	aborted atomic.Bool
+	cancel  context.CancelCauseFunc
+}
+
+const abortCtx key = "abort_context"
+
+// WithOperationAbort returns a copy of ctx that is cancelled when AbortOperation is called with it, or
+// with any context derived from it. The executor calls it for every operation it dispatches. Calling
+// cancel releases the resources of the context once the operation is done, without aborting it.
+func WithOperationAbort(ctx context.Context) (_ context.Context, cancel context.CancelFunc) {
+	ctx, cancelCause := context.WithCancelCause(ctx)
+	return context.WithValue(ctx, abortCtx, &operationAbort{cancel: cancelCause}), func() { cancelCause(nil) }
+}
+
+// AbortOperation stops the rest of the operation from being resolved. The error is added to the
+// response on the current path, the operation context is cancelled with it as the cause, and fields
+// that have not started resolving yet are returned as null, so the client gets whatever was resolved
+// before the abort. Only the first call has an effect.
+func AbortOperation(ctx context.Context, err error) {
+	abort, ok := ctx.Value(abortCtx).(*operationAbort)
+	if !ok {
+		panic("missing operation abort context")
+	}
+	if err == nil {
+		err = ErrOperationAborted
+	}
+
+	abort.once.Do(func() {
+		AddError(ctx, err)
+		abort.aborted.Store(true)
+		abort.cancel(err)
+	})
+}
+
+// IsOperationAborted returns true if AbortOperation has been called for the current operation.
+func IsOperationAborted(ctx context.Context) bool {
+	abort, ok := ctx.Value(abortCtx).(*operationAbort)
+	return ok && abort.aborted.Load()
+}
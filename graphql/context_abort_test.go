@@ -0,0 +1,71 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestAbortOperation(t *testing.T) {
+	newCtx := func(t *testing.T) context.Context {
+		ctx := WithResponseContext(context.Background(), DefaultErrorPresenter, nil)
+		ctx, cancel := WithOperationAbort(ctx)
+		t.Cleanup(cancel)
+		return WithFieldContext(ctx, &FieldContext{
+			Field: CollectedField{Field: &ast.Field{Alias: "foo"}},
+		})
+	}
+
+	t.Run("cancels the context with the error", func(t *testing.T) {
+		ctx := newCtx(t)
+		require.False(t, IsOperationAborted(ctx))
+
+		err := errors.New("stop")
+		AbortOperation(ctx, err)
+		AbortOperation(ctx, errors.New("ignored"))
+
+		require.True(t, IsOperationAborted(ctx))
+		require.ErrorIs(t, ctx.Err(), context.Canceled)
+		require.ErrorIs(t, context.Cause(ctx), err)
+		require.Len(t, GetErrors(ctx), 1)
+		require.Equal(t, "stop", GetErrors(ctx)[0].Message)
+		require.Equal(t, ast.Path{ast.PathName("foo")}, GetErrors(ctx)[0].Path)
+	})
+
+	t.Run("defaults the error", func(t *testing.T) {
+		ctx := newCtx(t)
+		AbortOperation(ctx, nil)
+		require.ErrorIs(t, context.Cause(ctx), ErrOperationAborted)
+		require.Equal(t, "operation aborted", GetErrors(ctx)[0].Message)
+	})
+
+	t.Run("is seen by derived contexts", func(t *testing.T) {
+		ctx := newCtx(t)
+		child, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		AbortOperation(child, nil)
+		require.True(t, IsOperationAborted(ctx))
+		require.True(t, IsOperationAborted(child))
+	})
+
+	t.Run("is not reported for a cancelled client", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(newCtx(t))
+		cancel()
+		require.False(t, IsOperationAborted(ctx))
+	})
+	t.Run("is not reported once the operation is done", func(t *testing.T) {
+		ctx := WithResponseContext(context.Background(), DefaultErrorPresenter, nil)
+		ctx, cancel := WithOperationAbort(ctx)
+		cancel()
+		require.ErrorIs(t, ctx.Err(), context.Canceled)
+		require.False(t, IsOperationAborted(ctx))
+	})
+
+	t.Run("is not reported without an abort context", func(t *testing.T) {
+		require.False(t, IsOperationAborted(context.Background()))
+	})
+}
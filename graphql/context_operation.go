@@ -16,10 +16,15 @@ type OperationContext struct {
 	RawQuery      string
 	Variables     map[string]any
 	OperationName string
-	Doc           *ast.QueryDocument
-	Extensions    map[string]any
-	Headers       http.Header
-
+	// Doc is the whole parsed query document, which may contain several operations and fragments.
+	Doc        *ast.QueryDocument
+	Extensions map[string]any
+	Headers    http.Header
+	// RequestID identifies the request this operation belongs to. It is set by the RequestID extension.
+	RequestID string
+
+	// Operation is the operation selected from Doc by OperationName that is being executed, including
+	// its name, type, variable definitions and selection set.
 	Operation              *ast.OperationDefinition
 	DisableIntrospection   bool
 	RecoverFunc            RecoverFunc
@@ -108,7 +113,8 @@ func (c *OperationContext) Errorf(ctx context.Context, format string, args ...an
 	AddErrorf(ctx, format, args...)
 }
 
-// Error add error or multiple errors (if underlaying type is gqlerror.List) into the stack.
+// Error add error or multiple errors (if underlaying type is gqlerror.List, or it joins several
+// errors like those created by errors.Join) into the stack.
 // Then it will be sends to the client, passing it through the formatter.
 func (c *OperationContext) Error(ctx context.Context, err error) {
 	if errList, ok := err.(gqlerror.List); ok {
@@ -118,6 +124,13 @@ func (c *OperationContext) Error(ctx context.Context, err error) {
 		return
 	}
 
+	if errs := joinedErrors(err); len(errs) != 0 {
+		for _, e := range errs {
+			c.Error(ctx, e)
+		}
+		return
+	}
+
 	AddError(ctx, err)
 }
 
@@ -2,6 +2,8 @@ package graphql
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -59,6 +61,66 @@ func TestGetOperationContext(t *testing.T) {
 	})
 }
 
+// emptyMultiError wraps no errors, like an error type joining an empty set of errors.
+type emptyMultiError struct{}
+
+func (emptyMultiError) Error() string   { return "no errors" }
+func (emptyMultiError) Unwrap() []error { return nil }
+
+func TestOperationContextError(t *testing.T) {
+	opCtx := &OperationContext{}
+
+	t.Run("expands joined errors", func(t *testing.T) {
+		ctx := WithResponseContext(context.Background(), DefaultErrorPresenter, nil)
+		opCtx.Error(ctx, errors.Join(errors.New("error 1"), errors.New("error 2")))
+
+		errs := GetErrors(ctx)
+		require.Len(t, errs, 2)
+		require.Equal(t, "error 1", errs[0].Message)
+		require.Equal(t, "error 2", errs[1].Message)
+	})
+
+	t.Run("keeps errors wrapping no errors", func(t *testing.T) {
+		ctx := WithResponseContext(context.Background(), DefaultErrorPresenter, nil)
+		opCtx.Error(ctx, emptyMultiError{})
+
+		errs := GetErrors(ctx)
+		require.Len(t, errs, 1)
+		require.Equal(t, "no errors", errs[0].Message)
+	})
+
+	t.Run("keeps errors wrapping several errors with a message", func(t *testing.T) {
+		ctx := WithResponseContext(context.Background(), DefaultErrorPresenter, nil)
+		opCtx.Error(ctx, fmt.Errorf("validate batch: %w, %w", errors.New("error 1"), errors.New("error 2")))
+
+		errs := GetErrors(ctx)
+		require.Len(t, errs, 1)
+		require.Equal(t, "validate batch: error 1, error 2", errs[0].Message)
+	})
+
+	t.Run("expands joined errors with extensions", func(t *testing.T) {
+		ctx := WithResponseContext(context.Background(), DefaultErrorPresenter, nil)
+		opCtx.Error(ctx, WithExtension(errors.Join(errors.New("error 1"), errors.New("error 2")), "key", "value"))
+
+		errs := GetErrors(ctx)
+		require.Len(t, errs, 2)
+		require.Equal(t, "error 1", errs[0].Message)
+		require.Equal(t, map[string]any{"key": "value"}, errs[0].Extensions)
+		require.Equal(t, "error 2", errs[1].Message)
+		require.Equal(t, map[string]any{"key": "value"}, errs[1].Extensions)
+	})
+
+	t.Run("keeps errors wrapping several errors with extensions", func(t *testing.T) {
+		ctx := WithResponseContext(context.Background(), DefaultErrorPresenter, nil)
+		opCtx.Error(ctx, WithExtension(fmt.Errorf("validate batch: %w, %w", errors.New("error 1"), errors.New("error 2")), "key", "value"))
+
+		errs := GetErrors(ctx)
+		require.Len(t, errs, 1)
+		require.Equal(t, "validate batch: error 1, error 2", errs[0].Message)
+		require.Equal(t, map[string]any{"key": "value"}, errs[0].Extensions)
+	})
+}
+
 func TestCollectFields(t *testing.T) {
 	getNames := func(collected []CollectedField) []string {
 		names := make([]string, 0, len(collected))
@@ -414,6 +476,7 @@ func TestCollectFields(t *testing.T) {
 		collected := CollectFields(reqCtx, resCtx.Field.Selections, []string{"ExampleTypeA", "ExampleTypeB"})
 		require.Equal(t, []string{"fieldA", "fieldD"}, getNames(collected))
 	})
+
 }
 
 func TestCollectAllFields(t *testing.T) {
@@ -70,6 +70,10 @@ func Recover(ctx context.Context, err any) (userMessage error) {
 
 // HasFieldError returns true if the given field has already errored
 func HasFieldError(ctx context.Context, rctx *FieldContext) bool {
+	if IsOperationAborted(ctx) {
+		return true
+	}
+
 	c := getResponseContext(ctx)
 
 	c.errorsMu.Lock()
@@ -92,3 +92,89 @@ func TestGetErrorFromPresenter(t *testing.T) {
 	ctx = WithFieldContext(ctx, &FieldContext{})
 	AddError(ctx, errors.New("foo1"))
 }
+
+func TestAddCategorizedError(t *testing.T) {
+	ctx := WithResponseContext(context.Background(), DefaultErrorPresenter, nil)
+	ctx = WithFieldContext(ctx, &FieldContext{
+		Field: CollectedField{
+			Field: &ast.Field{
+				Alias: "foo",
+			},
+		},
+	})
+
+	AddError(ctx, WithCategory(errors.New("not allowed"), ErrorCategoryAuth))
+	AddError(ctx, WithCategory(&gqlerror.Error{
+		Message:    "bad input",
+		Extensions: map[string]any{"code": "BAD_INPUT"},
+	}, ErrorCategoryUser))
+	AddError(ctx, errors.New("uncategorized"))
+
+	errs := GetErrors(ctx)
+	require.Len(t, errs, 3)
+
+	assert.Equal(t, "not allowed", errs[0].Message)
+	assert.Equal(t, ast.Path{ast.PathName("foo")}, errs[0].Path)
+	assert.Equal(t, map[string]any{"category": "AUTH_ERROR"}, errs[0].Extensions)
+
+	assert.Equal(t, "bad input", errs[1].Message)
+	assert.Equal(t, map[string]any{"code": "BAD_INPUT", "category": "USER_ERROR"}, errs[1].Extensions)
+
+	assert.Nil(t, errs[2].Extensions)
+
+	require.NoError(t, WithCategory(nil, ErrorCategorySystem))
+}
+
+func TestMaskingErrorPresenter(t *testing.T) {
+	type logged struct {
+		path ast.Path
+		err  error
+	}
+	var logs []logged
+	presenter := MaskingErrorPresenter(func(ctx context.Context, path ast.Path, err error) {
+		logs = append(logs, logged{path: path, err: err})
+	})
+
+	ctx := WithResponseContext(context.Background(), presenter, nil)
+	ctx = WithFieldContext(ctx, &FieldContext{
+		Field: CollectedField{
+			Field: &ast.Field{
+				Alias: "foo",
+			},
+		},
+	})
+
+	internal := errors.New("pq: connection refused")
+	AddError(ctx, internal)
+	AddError(ctx, gqlerror.Errorf("name is required"))
+	AddError(ctx, WithCategory(errors.New("bad input"), ErrorCategoryUser))
+
+	errs := GetErrors(ctx)
+	require.Len(t, errs, 3)
+
+	assert.Equal(t, MaskedErrorMessage, errs[0].Message)
+	assert.Equal(t, ast.Path{ast.PathName("foo")}, errs[0].Path)
+	require.NoError(t, errs[0].Err)
+
+	assert.Equal(t, "name is required", errs[1].Message)
+	assert.Equal(t, "bad input", errs[2].Message)
+
+	require.Len(t, logs, 1)
+	assert.Equal(t, ast.Path{ast.PathName("foo")}, logs[0].path)
+	assert.Equal(t, internal, logs[0].err)
+}
+
+func TestAddCategorizedErrorDoesNotModifySharedErrors(t *testing.T) {
+	errNotAllowed := &gqlerror.Error{
+		Message:    "not allowed",
+		Extensions: map[string]any{"code": "FORBIDDEN"},
+	}
+
+	ctx := WithResponseContext(context.Background(), DefaultErrorPresenter, nil)
+	AddError(ctx, WithCategory(errNotAllowed, ErrorCategoryAuth))
+
+	errs := GetErrors(ctx)
+	require.Len(t, errs, 1)
+	assert.Equal(t, map[string]any{"code": "FORBIDDEN", "category": "AUTH_ERROR"}, errs[0].Extensions)
+	assert.Equal(t, map[string]any{"code": "FORBIDDEN"}, errNotAllowed.Extensions)
+}
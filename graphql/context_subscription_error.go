@@ -0,0 +1,56 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type subscriptionErrors struct {
+	mu   sync.Mutex
+	errs gqlerror.List
+}
+
+const subscriptionErrorsCtx key = "subscription_errors_context"
+
+// WithSubscriptionErrors returns a copy of ctx that collects the errors added with AddSubscriptionError.
+// Transports that can end a subscription with an error call it for every subscription, and send the
+// collected errors once the subscription is over.
+func WithSubscriptionErrors(ctx context.Context) context.Context {
+	return context.WithValue(ctx, subscriptionErrorsCtx, &subscriptionErrors{})
+}
+
+// AddSubscriptionError passes err through the error presenter and records it as the error ending the
+// subscription, the transport sends it to the client instead of completing the subscription normally.
+// It returns false if the transport does not collect subscription errors, in which case err is added
+// to the response like any other error.
+func AddSubscriptionError(ctx context.Context, err error) bool {
+	if err == nil {
+		return true
+	}
+	s, ok := ctx.Value(subscriptionErrorsCtx).(*subscriptionErrors)
+	if !ok {
+		AddError(ctx, err)
+		return false
+	}
+
+	presentedError := getResponseContext(ctx).errorPresenter(ctx, ErrorOnPath(ctx, err))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errs = append(s.errs, presentedError)
+	return true
+}
+
+// GetSubscriptionErrors returns the errors added with AddSubscriptionError.
+func GetSubscriptionErrors(ctx context.Context) gqlerror.List {
+	s, ok := ctx.Value(subscriptionErrorsCtx).(*subscriptionErrors)
+	if !ok {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append(gqlerror.List(nil), s.errs...)
+}
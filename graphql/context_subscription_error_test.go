@@ -0,0 +1,41 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestAddSubscriptionError(t *testing.T) {
+	newCtx := func() context.Context {
+		ctx := WithResponseContext(context.Background(), DefaultErrorPresenter, nil)
+		return WithFieldContext(ctx, &FieldContext{
+			Field: CollectedField{Field: &ast.Field{Alias: "foo"}},
+		})
+	}
+
+	t.Run("collects the error for the transport", func(t *testing.T) {
+		ctx := WithSubscriptionErrors(newCtx())
+
+		require.True(t, AddSubscriptionError(ctx, errors.New("stream failed")))
+
+		errs := GetSubscriptionErrors(ctx)
+		require.Len(t, errs, 1)
+		require.Equal(t, "stream failed", errs[0].Message)
+		require.Equal(t, ast.Path{ast.PathName("foo")}, errs[0].Path)
+		require.Empty(t, GetErrors(ctx))
+	})
+
+	t.Run("falls back to the response errors", func(t *testing.T) {
+		ctx := newCtx()
+
+		require.False(t, AddSubscriptionError(ctx, errors.New("stream failed")))
+
+		require.Empty(t, GetSubscriptionErrors(ctx))
+		require.Len(t, GetErrors(ctx), 1)
+		require.Equal(t, "stream failed", GetErrors(ctx)[0].Message)
+	})
+}
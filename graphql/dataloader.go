@@ -0,0 +1,137 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// BatchFunc loads the values of keys in a single call, returning them in the same order as keys.
+// It can fail the whole batch by returning a single error, or individual keys by returning one
+// error per key.
+type BatchFunc[K comparable, V any] func(ctx context.Context, keys []K) ([]V, []error)
+
+// Loader batches the keys loaded within a wait window into a single call of its BatchFunc, to
+// avoid the N+1 problem of resolving the same field of many objects. It doesn't cache values
+// between batches, keep one Loader per request when the BatchFunc reads data scoped to it.
+type Loader[K comparable, V any] struct {
+	fetch    BatchFunc[K, V]
+	wait     time.Duration
+	maxBatch int
+
+	mu    sync.Mutex
+	batch *loaderBatch[K, V]
+}
+
+type loaderBatch[K comparable, V any] struct {
+	ctx     context.Context
+	keys    []K
+	indexes map[K]int
+	timer   *time.Timer
+	done    chan struct{}
+	values  []V
+	errs    []error
+}
+
+// NewLoader creates a Loader calling fetch with the keys loaded within wait of the first key of a
+// batch. Batches are sent early once they reach maxBatch keys, a maxBatch of 0 disables the limit.
+func NewLoader[K comparable, V any](fetch BatchFunc[K, V], wait time.Duration, maxBatch int) *Loader[K, V] {
+	return &Loader[K, V]{
+		fetch:    fetch,
+		wait:     wait,
+		maxBatch: maxBatch,
+	}
+}
+
+// Load adds key to the current batch and waits for its value. Keys loaded more than once in a
+// batch are only fetched once.
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	b := l.batch
+	if b == nil {
+		b = &loaderBatch[K, V]{
+			// the batch outlives the load that started it, so it mustn't be cancelled with it
+			ctx:     context.WithoutCancel(ctx),
+			indexes: map[K]int{},
+			done:    make(chan struct{}),
+		}
+		b.timer = time.AfterFunc(l.wait, func() { l.dispatch(b) })
+		l.batch = b
+	}
+	i, ok := b.indexes[key]
+	if !ok {
+		i = len(b.keys)
+		b.indexes[key] = i
+		b.keys = append(b.keys, key)
+	}
+	full := l.maxBatch > 0 && len(b.keys) >= l.maxBatch
+	if full {
+		l.batch = nil
+	}
+	l.mu.Unlock()
+
+	if full && b.timer.Stop() {
+		l.dispatch(b)
+	}
+
+	select {
+	case <-b.done:
+		return b.result(i)
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// LoadAll loads each of keys, returning their values and errors in the same order.
+func (l *Loader[K, V]) LoadAll(ctx context.Context, keys []K) ([]V, []error) {
+	values := make([]V, len(keys))
+	errs := make([]error, len(keys))
+
+	var wg sync.WaitGroup
+	wg.Add(len(keys))
+	for i, key := range keys {
+		go func() {
+			defer wg.Done()
+			values[i], errs[i] = l.Load(ctx, key)
+		}()
+	}
+	wg.Wait()
+
+	return values, errs
+}
+
+func (l *Loader[K, V]) dispatch(b *loaderBatch[K, V]) {
+	l.mu.Lock()
+	if l.batch == b {
+		l.batch = nil
+	}
+	l.mu.Unlock()
+
+	defer close(b.done)
+	defer func() {
+		if r := recover(); r != nil {
+			b.errs = []error{fmt.Errorf("panic in batch func: %v", r)}
+		}
+	}()
+	b.values, b.errs = l.fetch(b.ctx, b.keys)
+}
+
+func (b *loaderBatch[K, V]) result(i int) (V, error) {
+	var value V
+	if i < len(b.values) {
+		value = b.values[i]
+	}
+
+	switch {
+	case len(b.errs) == 1:
+		return value, b.errs[0]
+	case i < len(b.errs):
+		return value, b.errs[i]
+	case len(b.errs) == 0 && len(b.values) != len(b.keys):
+		return value, fmt.Errorf("batch func returned %d values for %d keys", len(b.values), len(b.keys))
+	default:
+		return value, nil
+	}
+}
@@ -0,0 +1,150 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type recordingFetch struct {
+	mu      sync.Mutex
+	batches [][]int
+}
+
+func (r *recordingFetch) fetch(ctx context.Context, keys []int) ([]string, []error) {
+	r.mu.Lock()
+	r.batches = append(r.batches, append([]int(nil), keys...))
+	r.mu.Unlock()
+
+	values := make([]string, len(keys))
+	for i, key := range keys {
+		values[i] = strconv.Itoa(key)
+	}
+	return values, nil
+}
+
+func (r *recordingFetch) sortedBatches() [][]int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, b := range r.batches {
+		sort.Ints(b)
+	}
+	sort.Slice(r.batches, func(i, j int) bool { return r.batches[i][0] < r.batches[j][0] })
+	return r.batches
+}
+
+func TestLoader(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("batches concurrent loads within the window", func(t *testing.T) {
+		r := &recordingFetch{}
+		l := NewLoader(r.fetch, 20*time.Millisecond, 0)
+
+		values, errs := l.LoadAll(ctx, []int{1, 2, 3, 4, 5})
+		require.Equal(t, []string{"1", "2", "3", "4", "5"}, values)
+		require.Equal(t, make([]error, 5), errs)
+		require.Equal(t, [][]int{{1, 2, 3, 4, 5}}, r.sortedBatches())
+	})
+
+	t.Run("loads beyond the window start a new batch", func(t *testing.T) {
+		r := &recordingFetch{}
+		l := NewLoader(r.fetch, 5*time.Millisecond, 0)
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			v, err := l.Load(ctx, 1)
+			require.NoError(t, err)
+			require.Equal(t, "1", v)
+		}()
+		go func() {
+			defer wg.Done()
+			time.Sleep(50 * time.Millisecond)
+			v, err := l.Load(ctx, 2)
+			require.NoError(t, err)
+			require.Equal(t, "2", v)
+		}()
+		wg.Wait()
+
+		require.Equal(t, [][]int{{1}, {2}}, r.sortedBatches())
+	})
+
+	t.Run("batches are sent early once full", func(t *testing.T) {
+		r := &recordingFetch{}
+		l := NewLoader(r.fetch, time.Hour, 2)
+
+		values, errs := l.LoadAll(ctx, []int{1, 2, 3, 4})
+		require.Equal(t, []string{"1", "2", "3", "4"}, values)
+		require.Equal(t, make([]error, 4), errs)
+
+		batches := r.sortedBatches()
+		require.Len(t, batches, 2)
+		for _, b := range batches {
+			require.Len(t, b, 2)
+		}
+	})
+
+	t.Run("keys are fetched once per batch", func(t *testing.T) {
+		r := &recordingFetch{}
+		l := NewLoader(r.fetch, 20*time.Millisecond, 0)
+
+		values, _ := l.LoadAll(ctx, []int{1, 1, 2, 1})
+		require.Equal(t, []string{"1", "1", "2", "1"}, values)
+		require.Equal(t, [][]int{{1, 2}}, r.sortedBatches())
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		l := NewLoader(func(ctx context.Context, keys []int) ([]string, []error) {
+			return nil, []error{errors.New("boom")}
+		}, time.Millisecond, 0)
+		_, errs := l.LoadAll(ctx, []int{1, 2})
+		require.Equal(t, []error{errors.New("boom"), errors.New("boom")}, errs)
+
+		l = NewLoader(func(ctx context.Context, keys []int) ([]string, []error) {
+			errs := make([]error, len(keys))
+			for i, key := range keys {
+				if key == 2 {
+					errs[i] = errors.New("not found")
+				}
+			}
+			return make([]string, len(keys)), errs
+		}, 20*time.Millisecond, 0)
+		_, errs = l.LoadAll(ctx, []int{1, 2})
+		require.Equal(t, []error{nil, errors.New("not found")}, errs)
+
+		l = NewLoader(func(ctx context.Context, keys []int) ([]string, []error) {
+			return []string{"1"}, nil
+		}, 20*time.Millisecond, 0)
+		_, errs = l.LoadAll(ctx, []int{1, 2})
+		require.EqualError(t, errs[0], "batch func returned 1 values for 2 keys")
+
+		l = NewLoader(func(ctx context.Context, keys []int) ([]string, []error) {
+			panic("boom")
+		}, time.Millisecond, 0)
+		_, err := l.Load(ctx, 1)
+		require.EqualError(t, err, "panic in batch func: boom")
+	})
+
+	t.Run("loads stop waiting when their context is done", func(t *testing.T) {
+		r := &recordingFetch{}
+		l := NewLoader(r.fetch, 50*time.Millisecond, 0)
+
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err := l.Load(cancelled, 1)
+		require.ErrorIs(t, err, context.Canceled)
+
+		// the batch is still sent for the other loads
+		v, err := l.Load(ctx, 2)
+		require.NoError(t, err)
+		require.Equal(t, "2", v)
+		require.Equal(t, [][]int{{1, 2}}, r.sortedBatches())
+	})
+}
@@ -7,6 +7,7 @@ import (
 const (
 	ValidationFailed = "GRAPHQL_VALIDATION_FAILED"
 	ParseFailed      = "GRAPHQL_PARSE_FAILED"
+	EmptyQuery       = "GRAPHQL_EMPTY_QUERY"
 )
 
 type ErrorKind int
@@ -21,6 +22,7 @@ const (
 var codeType = map[string]ErrorKind{
 	ValidationFailed: KindProtocol,
 	ParseFailed:      KindProtocol,
+	EmptyQuery:       KindProtocol,
 }
 
 // RegisterErrorType should be called by extensions that want to customize the http status codes for
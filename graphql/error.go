@@ -3,21 +3,192 @@ package graphql
 import (
 	"context"
 	"errors"
+	"maps"
+	"reflect"
 
+	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 type ErrorPresenterFunc func(ctx context.Context, err error) *gqlerror.Error
 
+// Stable error categories, suitable for alerting on.
+const (
+	ErrorCategoryUser   = "USER_ERROR"
+	ErrorCategorySystem = "SYSTEM_ERROR"
+	ErrorCategoryAuth   = "AUTH_ERROR"
+)
+
+// CategorizedError is implemented by errors that carry a stable, machine-readable category.
+// DefaultErrorPresenter exposes the category as extensions.category.
+type CategorizedError interface {
+	error
+	Category() string
+}
+
+type categorizedError struct {
+	error
+	category string
+}
+
+func (e *categorizedError) Category() string {
+	return e.category
+}
+
+func (e *categorizedError) Unwrap() error {
+	return e.error
+}
+
+// WithCategory wraps err so that it reports the given category.
+func WithCategory(err error, category string) error {
+	if err == nil {
+		return nil
+	}
+	return &categorizedError{error: err, category: category}
+}
+
+// ExtendedError is implemented by errors that carry extensions for the error sent to clients.
+// DefaultErrorPresenter adds them to the extensions of the presented error.
+type ExtendedError interface {
+	error
+	Extensions() map[string]any
+}
+
+type extendedError struct {
+	error
+	extensions map[string]any
+}
+
+func (e *extendedError) Extensions() map[string]any {
+	return e.extensions
+}
+
+func (e *extendedError) Unwrap() error {
+	return e.error
+}
+
+// extendedErrors wraps errors joining several others, like those created by errors.Join, keeping
+// them separate so that each reports the extension.
+type extendedErrors struct {
+	error
+	errs []error
+}
+
+func (e *extendedErrors) Unwrap() []error {
+	return e.errs
+}
+
+// joinErrorType is the type of the errors created by errors.Join.
+var joinErrorType = reflect.TypeOf(errors.Join(errors.New("")))
+
+// joinedErrors returns the errors err joins when it is a gqlerror.List, was created by errors.Join,
+// or by WithExtension from one of them. Other errors wrapping several errors, like those created by
+// fmt.Errorf with several %w verbs, have a message of their own and are not split.
+func joinedErrors(err error) []error {
+	switch err := err.(type) {
+	case gqlerror.List:
+		return err.Unwrap()
+	case *extendedErrors:
+		return err.errs
+	}
+	if reflect.TypeOf(err) == joinErrorType {
+		return err.(interface{ Unwrap() []error }).Unwrap()
+	}
+	return nil
+}
+
+// WithExtension wraps err so that it reports value in the key extension. If err joins several
+// errors, like gqlerror.List or those created by errors.Join, each of them reports it.
+func WithExtension(err error, key string, value any) error {
+	if err == nil {
+		return nil
+	}
+	if err, ok := err.(*extendedError); ok {
+		extensions := maps.Clone(err.extensions)
+		extensions[key] = value
+		return &extendedError{error: err.error, extensions: extensions}
+	}
+	errs := joinedErrors(err)
+	if len(errs) == 0 {
+		return &extendedError{error: err, extensions: map[string]any{key: value}}
+	}
+	extended := make([]error, len(errs))
+	for i, e := range errs {
+		extended[i] = WithExtension(e, key, value)
+	}
+	return &extendedErrors{error: err, errs: extended}
+}
+
 func DefaultErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
 	if err == nil {
 		return nil
 	}
 	var gqlErr *gqlerror.Error
-	if errors.As(err, &gqlErr) {
+	if !errors.As(err, &gqlErr) {
+		gqlErr = gqlerror.WrapPath(GetPath(ctx), err)
+	}
+	return setErrorExtensions(gqlErr, err)
+}
+
+// MaskedErrorMessage is shown to clients in place of internal errors by MaskingErrorPresenter.
+const MaskedErrorMessage = "internal server error"
+
+// MaskingErrorPresenter returns an error presenter that hides internal errors from clients while keeping them
+// available for logging.
+//
+// Errors created as a *gqlerror.Error (eg. with gqlerror.Errorf) and errors categorized as ErrorCategoryUser are
+// presented as usual. Any other error is replaced with MaskedErrorMessage, and logFunc is called with the original
+// error and the path of the field that returned it.
+func MaskingErrorPresenter(logFunc func(ctx context.Context, path ast.Path, err error)) ErrorPresenterFunc {
+	return func(ctx context.Context, err error) *gqlerror.Error {
+		gqlErr := DefaultErrorPresenter(ctx, err)
+		if gqlErr == nil || gqlErr.Err == nil {
+			return gqlErr
+		}
+		var catErr CategorizedError
+		if errors.As(err, &catErr) && catErr.Category() == ErrorCategoryUser {
+			return gqlErr
+		}
+
+		if logFunc != nil {
+			logFunc(ctx, gqlErr.Path, gqlErr.Err)
+		}
+		return &gqlerror.Error{
+			Message:   MaskedErrorMessage,
+			Path:      gqlErr.Path,
+			Locations: gqlErr.Locations,
+		}
+	}
+}
+
+// setErrorExtensions returns gqlErr with the category and extensions of err added to its extensions,
+// keeping those it already has. gqlErr is copied rather than modified, as resolvers may return the same
+// *gqlerror.Error from concurrent fields.
+func setErrorExtensions(gqlErr *gqlerror.Error, err error) *gqlerror.Error {
+	extensions := map[string]any{}
+	var extErr ExtendedError
+	if errors.As(err, &extErr) {
+		maps.Copy(extensions, extErr.Extensions())
+	}
+	var catErr CategorizedError
+	if errors.As(err, &catErr) {
+		extensions["category"] = catErr.Category()
+	}
+	maps.DeleteFunc(extensions, func(key string, _ any) bool {
+		_, ok := gqlErr.Extensions[key]
+		return ok
+	})
+	if len(extensions) == 0 {
 		return gqlErr
 	}
-	return gqlerror.WrapPath(GetPath(ctx), err)
+
+	extended := *gqlErr
+	extended.Extensions = maps.Clone(gqlErr.Extensions)
+	if extended.Extensions == nil {
+		extended.Extensions = map[string]any{}
+	}
+	maps.Copy(extended.Extensions, extensions)
+	return &extended
 }
 
 func ErrorOnPath(ctx context.Context, err error) error {
@@ -0,0 +1,79 @@
+package executor
+
+import (
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/validator"
+)
+
+// normalizeEnumLiterals rewrites the enum values of doc that match a value of their enum in another
+// case, eg. active for ACTIVE, to the case declared in the schema, so they pass validation.
+func normalizeEnumLiterals(schema *ast.Schema, doc *ast.QueryDocument) {
+	observers := &validator.Events{}
+	observers.OnValue(func(walker *validator.Walker, value *ast.Value) {
+		if value.Kind != ast.EnumValue || value.Definition == nil || value.Definition.Kind != ast.Enum {
+			return
+		}
+		value.Raw = canonicalEnumValue(value.Definition, value.Raw)
+	})
+	validator.Walk(schema, doc, observers)
+}
+
+// normalizeEnumVariables rewrites the enum values of the variables of op, including the ones in lists
+// and input objects, to the case declared in the schema.
+func normalizeEnumVariables(schema *ast.Schema, op *ast.OperationDefinition, variables map[string]any) {
+	for _, def := range op.VariableDefinitions {
+		if value, ok := variables[def.Variable]; ok {
+			variables[def.Variable] = normalizeEnumValue(schema, def.Type, value)
+		}
+	}
+}
+
+func normalizeEnumValue(schema *ast.Schema, typ *ast.Type, value any) any {
+	if typ.Elem != nil {
+		list, ok := value.([]any)
+		if !ok {
+			// a single value is coerced to a list of one
+			return normalizeEnumValue(schema, typ.Elem, value)
+		}
+		for i, v := range list {
+			list[i] = normalizeEnumValue(schema, typ.Elem, v)
+		}
+		return list
+	}
+
+	def := schema.Types[typ.NamedType]
+	if def == nil {
+		return value
+	}
+	switch def.Kind {
+	case ast.Enum:
+		if str, ok := value.(string); ok {
+			return canonicalEnumValue(def, str)
+		}
+	case ast.InputObject:
+		if obj, ok := value.(map[string]any); ok {
+			for name, v := range obj {
+				if field := def.Fields.ForName(name); field != nil {
+					obj[name] = normalizeEnumValue(schema, field.Type, v)
+				}
+			}
+		}
+	}
+	return value
+}
+
+// canonicalEnumValue returns the value of def matching str in any case, preferring an exact match. str
+// is returned as is when no value matches, so it is rejected as usual.
+func canonicalEnumValue(def *ast.Definition, str string) string {
+	if def.EnumValues.ForName(str) != nil {
+		return str
+	}
+	for _, v := range def.EnumValues {
+		if strings.EqualFold(v.Name, str) {
+			return v.Name
+		}
+	}
+	return str
+}
@@ -2,6 +2,8 @@ package executor
 
 import (
 	"context"
+	"slices"
+	"strings"
 
 	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -23,10 +25,15 @@ type Executor struct {
 
 	errorPresenter graphql.ErrorPresenterFunc
 	recoverFunc    graphql.RecoverFunc
+	extensionsFunc graphql.RequestExtensionsFunc
 	queryCache     graphql.Cache[*ast.QueryDocument]
 
-	parserTokenLimit  int
-	disableSuggestion bool
+	parserTokenLimit         int
+	maxQueryLength           int
+	maxOperations            int
+	disableSuggestion        bool
+	ignoreUnknownInputFields bool
+	caseInsensitiveEnums     bool
 }
 
 var _ graphql.GraphExecutor = &Executor{}
@@ -48,6 +55,26 @@ func New(es graphql.ExecutableSchema) *Executor {
 func (e *Executor) CreateOperationContext(
 	ctx context.Context,
 	params *graphql.RawParams,
+) (*graphql.OperationContext, gqlerror.List) {
+	return e.createOperationContext(ctx, params, nil)
+}
+
+// CreateOperationContextFromDocument creates the context of an operation of doc, a document that was
+// already parsed and validated against the schema, eg. by a trusted caller within the process. Parsing
+// and validation are skipped, so doc must carry the definitions validation annotates it with. The query
+// of params is only kept as the RawQuery of the operation, its other fields are used as usual.
+func (e *Executor) CreateOperationContextFromDocument(
+	ctx context.Context,
+	doc *ast.QueryDocument,
+	params *graphql.RawParams,
+) (*graphql.OperationContext, gqlerror.List) {
+	return e.createOperationContext(ctx, params, doc)
+}
+
+func (e *Executor) createOperationContext(
+	ctx context.Context,
+	params *graphql.RawParams,
+	doc *ast.QueryDocument,
 ) (*graphql.OperationContext, gqlerror.List) {
 	opCtx := &graphql.OperationContext{
 		DisableIntrospection:   true,
@@ -61,6 +88,16 @@ func (e *Executor) CreateOperationContext(
 	}
 	ctx = graphql.WithOperationContext(ctx, opCtx)
 
+	if e.extensionsFunc != nil {
+		extensions, err := e.extensionsFunc(ctx, params.Extensions)
+		if err != nil {
+			gqlErr := gqlerror.WrapIfUnwrapped(err)
+			errcode.Set(gqlErr, errcode.ValidationFailed)
+			return opCtx, gqlerror.List{gqlErr}
+		}
+		params.Extensions = extensions
+	}
+
 	for _, p := range e.ext.operationParameterMutators {
 		if err := p.MutateOperationParameters(ctx, params); err != nil {
 			return opCtx, gqlerror.List{err}
@@ -72,10 +109,29 @@ func (e *Executor) CreateOperationContext(
 	opCtx.Extensions = params.Extensions
 	opCtx.Headers = params.Headers
 
-	var listErr gqlerror.List
-	opCtx.Doc, listErr = e.parseQuery(ctx, &opCtx.Stats, params.Query)
-	if len(listErr) != 0 {
-		return opCtx, listErr
+	if doc != nil {
+		now := graphql.Now()
+		opCtx.Stats.Parsing = graphql.TraceTiming{Start: now, End: now}
+		opCtx.Stats.Validation = graphql.TraceTiming{Start: now, End: now}
+		opCtx.Doc = doc
+	} else {
+		if e.maxQueryLength > 0 && len(params.Query) > e.maxQueryLength {
+			err := gqlerror.Errorf("query length of %d bytes exceeds the maximum of %d bytes", len(params.Query), e.maxQueryLength)
+			errcode.Set(err, errcode.ParseFailed)
+			return opCtx, gqlerror.List{err}
+		}
+
+		if strings.TrimSpace(params.Query) == "" {
+			err := gqlerror.Errorf("empty query, the request must contain a GraphQL document")
+			errcode.Set(err, errcode.EmptyQuery)
+			return opCtx, gqlerror.List{err}
+		}
+
+		var listErr gqlerror.List
+		opCtx.Doc, listErr = e.parseQuery(ctx, &opCtx.Stats, params.Query)
+		if len(listErr) != 0 {
+			return opCtx, listErr
+		}
 	}
 
 	opCtx.Operation = opCtx.Doc.Operations.ForName(params.OperationName)
@@ -85,6 +141,20 @@ func (e *Executor) CreateOperationContext(
 		return opCtx, gqlerror.List{err}
 	}
 
+	if gqlErr := checkFragmentDepth(opCtx.Doc, opCtx.Operation); gqlErr != nil {
+		errcode.Set(gqlErr, errcode.ValidationFailed)
+		return opCtx, gqlerror.List{gqlErr}
+	}
+
+	if gqlErr := checkUnknownInputFields(e.es.Schema(), opCtx.Operation, params.Variables, e.ignoreUnknownInputFields); gqlErr != nil {
+		errcode.Set(gqlErr, errcode.ValidationFailed)
+		return opCtx, gqlerror.List{gqlErr}
+	}
+
+	if e.caseInsensitiveEnums {
+		normalizeEnumVariables(e.es.Schema(), opCtx.Operation, params.Variables)
+	}
+
 	var err error
 	opCtx.Variables, err = validator.VariableValues(e.es.Schema(), opCtx.Operation, params.Variables)
 	if err != nil {
@@ -113,11 +183,13 @@ func (e *Executor) DispatchOperation(
 
 	var innerCtx context.Context
 	res := e.ext.operationMiddleware(ctx, func(ctx context.Context) graphql.ResponseHandler {
+		ctx, cancelAbort := graphql.WithOperationAbort(ctx)
 		innerCtx = ctx
 
 		tmpResponseContext := graphql.WithResponseContext(ctx, e.errorPresenter, e.recoverFunc)
 		responses := e.es.Exec(tmpResponseContext)
 		if errs := graphql.GetErrors(tmpResponseContext); errs != nil {
+			cancelAbort()
 			return graphql.OneShot(&graphql.Response{Errors: errs})
 		}
 
@@ -132,9 +204,14 @@ func (e *Executor) DispatchOperation(
 				resp.Extensions = graphql.GetExtensions(ctx)
 				return resp
 			})
+			// the operation is done after its last response, subscriptions end with a nil one.
 			if resp == nil {
+				cancelAbort()
 				return nil
 			}
+			if opCtx.Operation.Operation != ast.Subscription && (resp.HasNext == nil || !*resp.HasNext) {
+				cancelAbort()
+			}
 
 			return resp
 		}
@@ -176,14 +253,44 @@ func (e *Executor) SetRecoverFunc(f graphql.RecoverFunc) {
 	e.recoverFunc = f
 }
 
+// SetRequestExtensionsFunc sets a hook to validate or transform the extensions of incoming requests.
+func (e *Executor) SetRequestExtensionsFunc(f graphql.RequestExtensionsFunc) {
+	e.extensionsFunc = f
+}
+
 func (e *Executor) SetParserTokenLimit(limit int) {
 	e.parserTokenLimit = limit
 }
 
+// SetMaxQueryLength rejects queries longer than limit bytes before they are parsed. A limit of 0
+// disables the check.
+func (e *Executor) SetMaxQueryLength(limit int) {
+	e.maxQueryLength = limit
+}
+
+// SetMaxOperationsPerDocument rejects documents declaring more than limit operations once they are
+// parsed. A limit of 0 disables the check.
+func (e *Executor) SetMaxOperationsPerDocument(limit int) {
+	e.maxOperations = limit
+}
+
 func (e *Executor) SetDisableSuggestion(value bool) {
 	e.disableSuggestion = value
 }
 
+// SetIgnoreUnknownInputFields drops the fields of input object variables that are not defined by the
+// schema instead of rejecting the operation, eg. to keep old clients working after an input field is
+// removed.
+func (e *Executor) SetIgnoreUnknownInputFields(value bool) {
+	e.ignoreUnknownInputFields = value
+}
+
+// SetCaseInsensitiveEnums accepts enum values of queries and variables in any case, eg. active for
+// ACTIVE, by rewriting them to the case declared in the schema before they are validated.
+func (e *Executor) SetCaseInsensitiveEnums(value bool) {
+	e.caseInsensitiveEnums = value
+}
+
 // parseQuery decodes the incoming query and validates it, pulling from cache if present.
 //
 // NOTE: This should NOT look at variables, they will change per request. It should only parse and
@@ -223,6 +330,12 @@ func (e *Executor) parseQuery(
 		return nil, gqlerror.List{gqlErr}
 	}
 
+	if e.maxOperations > 0 && len(doc.Operations) > e.maxOperations {
+		gqlErr := gqlerror.Errorf("document contains %d operations, exceeding the maximum of %d", len(doc.Operations), e.maxOperations)
+		errcode.Set(gqlErr, errcode.ValidationFailed)
+		return nil, gqlerror.List{gqlErr}
+	}
+
 	// swap out the FieldsOnCorrectType rule with one that doesn't provide suggestions
 	if e.disableSuggestion {
 		validator.RemoveRule("FieldsOnCorrectType")
@@ -232,7 +345,16 @@ func (e *Executor) parseQuery(
 		validator.ReplaceRule(rule.Name, rule.RuleFunc)
 	}
 
+	if e.caseInsensitiveEnums {
+		normalizeEnumLiterals(e.es.Schema(), doc)
+	}
+
 	listErr := validator.Validate(e.es.Schema(), doc)
+	if len(e.ext.overriddenValidationRules) != 0 {
+		listErr = slices.DeleteFunc(listErr, func(err *gqlerror.Error) bool {
+			return e.ext.overriddenValidationRules[err.Rule]
+		})
+	}
 	if len(listErr) != 0 {
 		for _, e := range listErr {
 			errcode.Set(e, errcode.ValidationFailed)
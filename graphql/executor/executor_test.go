@@ -24,10 +24,17 @@ func TestExecutor(t *testing.T) {
 	})
 
 	t.Run("validates operation", func(t *testing.T) {
-		t.Run("no operation", func(t *testing.T) {
+		t.Run("empty query", func(t *testing.T) {
 			resp := query(exec, "", "")
 			assert.Empty(t, string(resp.Data))
 			assert.Len(t, resp.Errors, 1)
+			assert.Equal(t, errcode.EmptyQuery, resp.Errors[0].Extensions["code"])
+		})
+
+		t.Run("no operation", func(t *testing.T) {
+			resp := query(exec, "", "fragment F on Query { name }")
+			assert.Empty(t, string(resp.Data))
+			assert.Len(t, resp.Errors, 1)
 			assert.Equal(t, errcode.ValidationFailed, resp.Errors[0].Extensions["code"])
 		})
 
@@ -169,6 +176,22 @@ func TestExecutor(t *testing.T) {
 	})
 }
 
+func TestExecutorOperationContextIsReleased(t *testing.T) {
+	exec := testexecutor.New()
+
+	ctx := graphql.StartOperationTrace(context.Background())
+	rc, err := exec.CreateOperationContext(ctx, &graphql.RawParams{Query: "{name}"})
+	require.Nil(t, err)
+
+	responses, ctx := exec.DispatchOperation(ctx, rc)
+	require.NoError(t, ctx.Err())
+
+	resp := responses(ctx)
+	assert.JSONEq(t, `{"name":"test"}`, string(resp.Data))
+	require.ErrorIs(t, ctx.Err(), context.Canceled)
+	require.False(t, graphql.IsOperationAborted(ctx))
+}
+
 func TestExecutorDisableSuggestion(t *testing.T) {
 	exec := testexecutor.New()
 	t.Run("by default, the error message will include suggestions", func(t *testing.T) {
@@ -192,6 +215,121 @@ func TestExecutorDisableSuggestion(t *testing.T) {
 	})
 }
 
+func TestExecutorMaxQueryLength(t *testing.T) {
+	exec := testexecutor.New()
+	exec.SetMaxQueryLength(10)
+
+	t.Run("query within the limit", func(t *testing.T) {
+		resp := query(exec, "", "{name}")
+		assert.JSONEq(t, `{"name":"test"}`, string(resp.Data))
+		assert.Empty(t, resp.Errors)
+	})
+
+	t.Run("query above the limit", func(t *testing.T) {
+		resp := query(exec, "", "{ name name }")
+		assert.Empty(t, string(resp.Data))
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, "query length of 13 bytes exceeds the maximum of 10 bytes", resp.Errors[0].Message)
+		assert.Equal(t, errcode.ParseFailed, resp.Errors[0].Extensions["code"])
+	})
+
+	t.Run("unparseable query above the limit is not parsed", func(t *testing.T) {
+		resp := query(exec, "", "{{{{{{{{{{{{")
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, "query length of 12 bytes exceeds the maximum of 10 bytes", resp.Errors[0].Message)
+	})
+}
+
+func TestExecutorMaxOperationsPerDocument(t *testing.T) {
+	exec := testexecutor.New()
+	exec.SetMaxOperationsPerDocument(2)
+
+	t.Run("document at the limit", func(t *testing.T) {
+		resp := query(exec, "a", "query a { name } query b { name }")
+		assert.JSONEq(t, `{"name":"test"}`, string(resp.Data))
+		assert.Empty(t, resp.Errors)
+	})
+
+	t.Run("document above the limit", func(t *testing.T) {
+		resp := query(exec, "a", "query a { name } query b { name } query c { name }")
+		assert.Empty(t, string(resp.Data))
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, "document contains 3 operations, exceeding the maximum of 2", resp.Errors[0].Message)
+		assert.Equal(t, errcode.ValidationFailed, resp.Errors[0].Extensions["code"])
+	})
+}
+
+func TestExecutorCreateOperationContextFromDocument(t *testing.T) {
+	exec := testexecutor.New()
+
+	var opCtx *graphql.OperationContext
+	exec.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		opCtx = graphql.GetOperationContext(ctx)
+		return next(ctx)
+	})
+
+	doc := &ast.QueryDocument{
+		Operations: ast.OperationList{{
+			Operation: ast.Query,
+			Name:      "Find",
+			VariableDefinitions: ast.VariableDefinitionList{{
+				Variable: "id",
+				Type:     ast.NonNullNamedType("Int", nil),
+				// set by validation
+				Definition: &ast.Definition{Kind: ast.Scalar, Name: "Int"},
+			}},
+			SelectionSet: ast.SelectionSet{&ast.Field{
+				Alias: "find",
+				Name:  "find",
+				Arguments: ast.ArgumentList{{
+					Name:  "id",
+					Value: &ast.Value{Kind: ast.Variable, Raw: "id"},
+				}},
+			}},
+		}},
+	}
+
+	execute := func(params *graphql.RawParams) *graphql.Response {
+		ctx := graphql.StartOperationTrace(context.Background())
+		rc, err := exec.CreateOperationContextFromDocument(ctx, doc, params)
+		if err != nil {
+			return exec.DispatchError(graphql.WithOperationContext(ctx, rc), err)
+		}
+		resp, ctx := exec.DispatchOperation(ctx, rc)
+		return resp(ctx)
+	}
+
+	t.Run("executes the document without parsing a query", func(t *testing.T) {
+		resp := execute(&graphql.RawParams{
+			OperationName: "Find",
+			Variables:     map[string]any{"id": 1},
+		})
+		assert.JSONEq(t, `{"name":"test"}`, string(resp.Data))
+		assert.Empty(t, resp.Errors)
+
+		require.NotNil(t, opCtx)
+		assert.Same(t, doc, opCtx.Doc)
+		assert.Same(t, doc.Operations[0], opCtx.Operation)
+		assert.Equal(t, map[string]any{"id": 1}, opCtx.Variables)
+	})
+
+	t.Run("variables are still validated", func(t *testing.T) {
+		resp := execute(&graphql.RawParams{
+			OperationName: "Find",
+			Variables:     map[string]any{"id": "one"},
+		})
+		assert.Empty(t, string(resp.Data))
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, errcode.ValidationFailed, resp.Errors[0].Extensions["code"])
+	})
+
+	t.Run("unknown operation", func(t *testing.T) {
+		resp := execute(&graphql.RawParams{OperationName: "Missing"})
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, "operation Missing not found", resp.Errors[0].Message)
+	})
+}
+
 type testParamMutator struct {
 	Mutate func(context.Context, *graphql.RawParams) *gqlerror.Error
 }
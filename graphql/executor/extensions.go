@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/vektah/gqlparser/v2/ast"
+
 	"github.com/99designs/gqlgen/graphql"
 )
 
@@ -20,7 +22,8 @@ func (e *Executor) Use(extension graphql.HandlerExtension) {
 		graphql.OperationInterceptor,
 		graphql.RootFieldInterceptor,
 		graphql.FieldInterceptor,
-		graphql.ResponseInterceptor:
+		graphql.ResponseInterceptor,
+		graphql.ValidationRuleOverride:
 		e.extensions = append(e.extensions, extension)
 		e.ext = processExtensions(e.extensions)
 
@@ -44,6 +47,37 @@ func (e *Executor) AroundOperations(f graphql.OperationMiddleware) {
 	e.Use(aroundOpFunc(f))
 }
 
+// AroundOperationsOfType is a convenience method for creating an extension that only implements operation middleware,
+// and is only called for operations of the given type
+func (e *Executor) AroundOperationsOfType(operation ast.Operation, f graphql.OperationMiddleware) {
+	if f == nil {
+		e.Use(aroundOpFunc(nil))
+		return
+	}
+	e.Use(aroundOpFunc(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		opCtx := graphql.GetOperationContext(ctx)
+		if opCtx.Operation == nil || opCtx.Operation.Operation != operation {
+			return next(ctx)
+		}
+		return f(ctx, next)
+	}))
+}
+
+// AroundSubscriptions is a convenience method for creating an extension that only implements operation middleware,
+// and is only called for subscription operations
+func (e *Executor) AroundSubscriptions(f graphql.OperationMiddleware) {
+	e.AroundOperationsOfType(ast.Subscription, f)
+}
+
+// WithContextValue is a convenience method for creating an extension that only implements operation middleware,
+// and adds the value returned by valueFunc to the context of every operation under key, so that resolvers can
+// read it with ctx.Value(key)
+func (e *Executor) WithContextValue(key any, valueFunc func(ctx context.Context) any) {
+	e.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		return next(context.WithValue(ctx, key, valueFunc(ctx)))
+	})
+}
+
 // AroundResponses is a convenience method for creating an extension that only implements response middleware
 func (e *Executor) AroundResponses(f graphql.ResponseMiddleware) {
 	e.Use(aroundRespFunc(f))
@@ -56,6 +90,7 @@ type extensions struct {
 	fieldMiddleware            graphql.FieldMiddleware
 	operationParameterMutators []graphql.OperationParameterMutator
 	operationContextMutators   []graphql.OperationContextMutator
+	overriddenValidationRules  map[string]bool
 }
 
 func processExtensions(exts []graphql.HandlerExtension) extensions {
@@ -70,7 +105,8 @@ func processExtensions(exts []graphql.HandlerExtension) extensions {
 			return next(ctx)
 		},
 		fieldMiddleware: func(ctx context.Context, next graphql.Resolver) (res any, err error) {
-			return next(ctx)
+			res, err = next(ctx)
+			return await(ctx, res, err)
 		},
 	}
 
@@ -107,13 +143,23 @@ func processExtensions(exts []graphql.HandlerExtension) extensions {
 		if p, ok := p.(graphql.FieldInterceptor); ok {
 			previous := e.fieldMiddleware
 			e.fieldMiddleware = func(ctx context.Context, next graphql.Resolver) (res any, err error) {
-				return p.InterceptField(ctx, func(ctx context.Context) (res any, err error) {
+				res, err = p.InterceptField(ctx, func(ctx context.Context) (res any, err error) {
 					return previous(ctx, next)
 				})
+				return await(ctx, res, err)
 			}
 		}
 	}
 
+	// once an operation is aborted the fields that have not started resolving yet are skipped.
+	resolve := e.fieldMiddleware
+	e.fieldMiddleware = func(ctx context.Context, next graphql.Resolver) (res any, err error) {
+		if graphql.IsOperationAborted(ctx) {
+			return nil, nil
+		}
+		return resolve(ctx, next)
+	}
+
 	for _, p := range exts {
 		if p, ok := p.(graphql.OperationParameterMutator); ok {
 			e.operationParameterMutators = append(e.operationParameterMutators, p)
@@ -122,11 +168,29 @@ func processExtensions(exts []graphql.HandlerExtension) extensions {
 		if p, ok := p.(graphql.OperationContextMutator); ok {
 			e.operationContextMutators = append(e.operationContextMutators, p)
 		}
+
+		if p, ok := p.(graphql.ValidationRuleOverride); ok {
+			if e.overriddenValidationRules == nil {
+				e.overriddenValidationRules = map[string]bool{}
+			}
+			for _, rule := range p.OverriddenValidationRules() {
+				e.overriddenValidationRules[rule] = true
+			}
+		}
 	}
 
 	return e
 }
 
+// await resolves a future returned by a resolver or field middleware, so the middleware wrapping it
+// and the generated code only see values.
+func await(ctx context.Context, res any, err error) (any, error) {
+	if f, ok := res.(graphql.Future); ok && err == nil {
+		return f.Await(ctx)
+	}
+	return res, err
+}
+
 type aroundOpFunc func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler
 
 func (r aroundOpFunc) ExtensionName() string {
@@ -0,0 +1,64 @@
+package executor
+
+import (
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// maxFragmentDepth caps how deeply fragment spreads and inline fragments may be nested within a
+// single selection set. The validator rejects fragment cycles, but deep chains of distinct
+// fragments can still cause a large amount of work while collecting fields.
+const maxFragmentDepth = 64
+
+// checkFragmentDepth returns an error when fragments are nested more than maxFragmentDepth deep within
+// any selection set of op.
+func checkFragmentDepth(doc *ast.QueryDocument, op *ast.OperationDefinition) *gqlerror.Error {
+	c := fragmentDepthChecker{doc: doc, depths: map[string]int{}}
+	if c.depth(op.SelectionSet) > maxFragmentDepth || c.tooDeep {
+		return gqlerror.Errorf("fragments are nested too deeply, the maximum depth is %d", maxFragmentDepth)
+	}
+	return nil
+}
+
+type fragmentDepthChecker struct {
+	doc     *ast.QueryDocument
+	depths  map[string]int
+	tooDeep bool
+}
+
+// depth returns how deeply fragments are nested within selSet. The selection sets of fields start
+// over at 0, so they are only checked against the limit.
+func (c *fragmentDepthChecker) depth(selSet ast.SelectionSet) int {
+	maxDepth := 0
+	for _, sel := range selSet {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			if c.depth(sel.SelectionSet) > maxFragmentDepth {
+				c.tooDeep = true
+			}
+		case *ast.InlineFragment:
+			maxDepth = max(maxDepth, 1+c.depth(sel.SelectionSet))
+		case *ast.FragmentSpread:
+			maxDepth = max(maxDepth, 1+c.fragmentDepth(sel.Name))
+		}
+		if c.tooDeep {
+			return maxDepth
+		}
+	}
+	return maxDepth
+}
+
+func (c *fragmentDepthChecker) fragmentDepth(name string) int {
+	if d, ok := c.depths[name]; ok {
+		return d
+	}
+	fragment := c.doc.Fragments.ForName(name)
+	if fragment == nil {
+		return 0
+	}
+	// guards against cycles, which validation rejects but documents that skip it may still contain
+	c.depths[name] = 0
+	d := c.depth(fragment.SelectionSet)
+	c.depths[name] = d
+	return d
+}
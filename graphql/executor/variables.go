@@ -0,0 +1,66 @@
+package executor
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// checkUnknownInputFields looks for fields of input object variables that are not defined by the schema.
+// They are removed from the variables when remove is true, otherwise the first one is returned as an
+// error naming the field and its input type.
+func checkUnknownInputFields(schema *ast.Schema, op *ast.OperationDefinition, variables map[string]any, remove bool) *gqlerror.Error {
+	for _, def := range op.VariableDefinitions {
+		value, ok := variables[def.Variable]
+		if !ok {
+			continue
+		}
+		path := ast.Path{ast.PathName("variable"), ast.PathName(def.Variable)}
+		if err := checkUnknownFields(schema, def.Type, value, path, remove); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkUnknownFields(schema *ast.Schema, typ *ast.Type, value any, path ast.Path, remove bool) *gqlerror.Error {
+	if typ.Elem != nil {
+		list, ok := value.([]any)
+		if !ok {
+			// a single value is coerced to a list of one
+			return checkUnknownFields(schema, typ.Elem, value, path, remove)
+		}
+		for i, v := range list {
+			if err := checkUnknownFields(schema, typ.Elem, v, append(slices.Clip(path), ast.PathIndex(i)), remove); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	def := schema.Types[typ.NamedType]
+	obj, ok := value.(map[string]any)
+	if def == nil || def.Kind != ast.InputObject || !ok {
+		return nil
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(obj)) {
+		fieldPath := append(slices.Clip(path), ast.PathName(name))
+		field := def.Fields.ForName(name)
+		switch {
+		case name == "__typename":
+			continue
+		case field == nil && remove:
+			delete(obj, name)
+		case field == nil:
+			return gqlerror.ErrorPathf(fieldPath, "unknown field %q on input type %q", name, def.Name)
+		default:
+			if err := checkUnknownFields(schema, field.Type, obj[name], fieldPath, remove); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+)
+
+// Future is a result that is not available yet, such as the result of a job handed to an async job
+// system. The executor detects futures returned by resolvers, or by field middleware in place of the
+// result of a field, and awaits them in the goroutine resolving the field, so fields that are
+// resolved concurrently await their futures concurrently too.
+type Future interface {
+	Await(ctx context.Context) (any, error)
+}
+
+// FutureFunc is a Future of a T computed by calling the func. The resolvers of fields configured with
+// future: true return one in place of their result. A nil FutureFunc resolves to the zero T.
+type FutureFunc[T any] func(ctx context.Context) (T, error)
+
+func (f FutureFunc[T]) Await(ctx context.Context) (any, error) {
+	if f == nil {
+		var zero T
+		return zero, nil
+	}
+	return f(ctx)
+}
+
+// FutureChan is a Future resolving to the first value received from ch. It fails when ch is closed
+// first, or when the context is done.
+func FutureChan[T any](ch <-chan T) FutureFunc[T] {
+	return func(ctx context.Context) (T, error) {
+		var zero T
+		select {
+		case v, ok := <-ch:
+			if !ok {
+				return zero, errors.New("future channel closed without a value")
+			}
+			return v, nil
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		}
+	}
+}
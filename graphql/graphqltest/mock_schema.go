@@ -0,0 +1,29 @@
+// Package graphqltest provides helpers for testing code that serves an executable schema, such as
+// transports and handler extensions, without relying on generated code.
+package graphqltest
+
+import (
+	"context"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// NewMockSchema returns an ExecutableSchemaMock serving the schema defined by sdl, which executes
+// operations with exec. It panics if sdl isn't a valid schema. ExecFunc can be replaced on the
+// returned mock, and the complexity of every field is left to the default calculation.
+func NewMockSchema(sdl string, exec func(ctx context.Context) graphql.ResponseHandler) *graphql.ExecutableSchemaMock {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: sdl})
+
+	return &graphql.ExecutableSchemaMock{
+		ExecFunc: exec,
+		SchemaFunc: func() *ast.Schema {
+			return schema
+		},
+		ComplexityFunc: func(ctx context.Context, typeName, fieldName string, childComplexity int, args map[string]any) (int, bool) {
+			return 0, false
+		},
+	}
+}
@@ -0,0 +1,77 @@
+package graphqltest_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/graphqltest"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestNewMockSchema(t *testing.T) {
+	es := graphqltest.NewMockSchema(`
+		type Query {
+			name: String!
+		}
+		type Subscription {
+			count: Int!
+		}
+	`, func(ctx context.Context) graphql.ResponseHandler {
+		if graphql.GetOperationContext(ctx).Operation.Operation == ast.Query {
+			return graphql.OneShot(&graphql.Response{Data: []byte(`{"name":"test"}`)})
+		}
+		i := 0
+		return func(ctx context.Context) *graphql.Response {
+			if i == 2 {
+				return nil
+			}
+			i++
+			return &graphql.Response{Data: []byte(`{"count":` + strconv.Itoa(i) + `}`)}
+		}
+	})
+
+	h := handler.New(es)
+	h.AddTransport(transport.SSE{})
+	h.AddTransport(transport.POST{})
+
+	t.Run("query", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":"{ name }"}`))
+		r.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.JSONEq(t, `{"data":{"name":"test"}}`, w.Body.String())
+	})
+
+	t.Run("subscription", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":"subscription { count }"}`))
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set("Accept", "text/event-stream")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.Equal(t, ":\n\nevent: next\ndata: {\"data\":{\"count\":1}}\n\nevent: next\ndata: {\"data\":{\"count\":2}}\n\nevent: complete\n\n", w.Body.String())
+	})
+
+	t.Run("invalid queries are validated against the schema", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":"{ unknown }"}`))
+		r.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code, w.Body.String())
+		assert.Contains(t, w.Body.String(), `Cannot query field \"unknown\" on type \"Query\".`)
+	})
+}
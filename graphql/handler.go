@@ -32,6 +32,11 @@ type (
 		ReadTime TraceTiming `json:"-"`
 	}
 
+	// RequestExtensionsFunc is called with the raw extensions of each incoming request before any
+	// OperationParameterMutator runs. The returned map replaces the request extensions, allowing unknown keys to be
+	// stripped, and returning an error rejects the request.
+	RequestExtensionsFunc func(ctx context.Context, extensions map[string]any) (map[string]any, error)
+
 	GraphExecutor interface {
 		CreateOperationContext(ctx context.Context, params *RawParams) (*OperationContext, gqlerror.List)
 		DispatchOperation(ctx context.Context, opCtx *OperationContext) (ResponseHandler, context.Context)
@@ -89,6 +94,13 @@ type (
 		InterceptField(ctx context.Context, next Resolver) (res any, err error)
 	}
 
+	// ValidationRuleOverride is implemented by extensions checking part of the validation of documents
+	// themselves, eg. to report some issues as warnings. The executor ignores the errors of the
+	// gqlparser validation rules it names.
+	ValidationRuleOverride interface {
+		OverriddenValidationRules() []string
+	}
+
 	// Transport provides support for different wire level encodings of graphql requests, eg Form, Get, Post, Websocket
 	Transport interface {
 		Supports(r *http.Request) bool
@@ -135,7 +135,7 @@ func TestApolloTracing_withMissingOp(t *testing.T) {
 	}
 	require.NoError(t, json.Unmarshal(b, &respData))
 	require.Len(t, respData.Errors, 1)
-	require.Equal(t, "no operation provided", respData.Errors[0].Message)
+	require.Equal(t, "empty query, the request must contain a GraphQL document", respData.Errors[0].Message)
 }
 
 func TestApolloTracing_withUnexpectedEOF(t *testing.T) {
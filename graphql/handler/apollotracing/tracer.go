@@ -11,7 +11,11 @@ import (
 )
 
 type (
-	Tracer struct{}
+	Tracer struct {
+		// Header, when set, only traces operations whose request carries this header with a non-empty
+		// value, eg "X-Apollo-Tracing: 1". When empty every operation is traced.
+		Header string
+	}
 
 	TracingExtension struct {
 		mu         sync.Mutex
@@ -85,12 +89,15 @@ func (Tracer) InterceptField(ctx context.Context, next graphql.Resolver) (any, e
 	return next(ctx)
 }
 
-func (Tracer) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+func (t Tracer) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
 	if !graphql.HasOperationContext(ctx) {
 		return next(ctx)
 	}
 
 	opCtx := graphql.GetOperationContext(ctx)
+	if t.Header != "" && opCtx.Headers.Get(t.Header) == "" {
+		return next(ctx)
+	}
 
 	start := opCtx.Stats.OperationStart
 
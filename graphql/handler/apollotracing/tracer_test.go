@@ -73,6 +73,37 @@ func TestApolloTracing(t *testing.T) {
 	require.Equal(t, "String!", tracing.Execution.Resolvers[0].ReturnType)
 }
 
+func TestApolloTracing_withHeader(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(transport.POST{})
+	h.Use(apollotracing.Tracer{Header: "X-Apollo-Tracing"})
+
+	t.Run("without header", func(t *testing.T) {
+		resp := doRequest(h, http.MethodPost, "/graphql", `{"query":"{ name }"}`)
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+	})
+
+	t.Run("with header", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":"{ name }"}`))
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set("X-Apollo-Tracing", "1")
+		resp := httptest.NewRecorder()
+		h.ServeHTTP(resp, r)
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+		var respData struct {
+			Extensions struct {
+				Tracing *apollotracing.TracingExtension `json:"tracing"`
+			} `json:"extensions"`
+		}
+		require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &respData))
+		require.NotNil(t, respData.Extensions.Tracing)
+		require.EqualValues(t, 1, respData.Extensions.Tracing.Version)
+		require.Len(t, respData.Extensions.Tracing.Execution.Resolvers, 1)
+	})
+}
+
 func TestApolloTracing_withFail(t *testing.T) {
 	now := time.Unix(0, 0)
 
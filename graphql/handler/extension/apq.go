@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
+	"time"
 
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -16,6 +17,7 @@ import (
 const (
 	errPersistedQueryNotFound     = "PersistedQueryNotFound"
 	errPersistedQueryNotFoundCode = "PERSISTED_QUERY_NOT_FOUND"
+	errRetryAfterExtension        = "retryAfter"
 )
 
 // AutomaticPersistedQuery saves client upload by optimistically sending only the hashes of queries, if the server
@@ -52,7 +54,29 @@ func (a AutomaticPersistedQuery) Validate(schema graphql.ExecutableSchema) error
 	return nil
 }
 
+// WithRetryAfter returns the extension adding retryAfter to PersistedQueryNotFound errors as the
+// retryAfter extension, in seconds, to hint clients to wait before sending the full query. The POST and
+// GET transports also send it as a Retry-After header when their SetRetryAfterHeader option is enabled.
+func (a AutomaticPersistedQuery) WithRetryAfter(retryAfter time.Duration) graphql.HandlerExtension {
+	return apqWithRetryAfter{AutomaticPersistedQuery: a, retryAfter: retryAfter}
+}
+
+type apqWithRetryAfter struct {
+	AutomaticPersistedQuery
+	retryAfter time.Duration
+}
+
+var _ graphql.OperationParameterMutator = apqWithRetryAfter{}
+
+func (a apqWithRetryAfter) MutateOperationParameters(ctx context.Context, rawParams *graphql.RawParams) *gqlerror.Error {
+	return a.mutateOperationParameters(ctx, rawParams, a.retryAfter)
+}
+
 func (a AutomaticPersistedQuery) MutateOperationParameters(ctx context.Context, rawParams *graphql.RawParams) *gqlerror.Error {
+	return a.mutateOperationParameters(ctx, rawParams, 0)
+}
+
+func (a AutomaticPersistedQuery) mutateOperationParameters(ctx context.Context, rawParams *graphql.RawParams, retryAfter time.Duration) *gqlerror.Error {
 	if rawParams.Extensions["persistedQuery"] == nil {
 		return nil
 	}
@@ -78,6 +102,9 @@ func (a AutomaticPersistedQuery) MutateOperationParameters(ctx context.Context,
 		if !ok {
 			err := gqlerror.Errorf(errPersistedQueryNotFound)
 			errcode.Set(err, errPersistedQueryNotFoundCode)
+			if retryAfter > 0 {
+				err.Extensions[errRetryAfterExtension] = retryAfter.Seconds()
+			}
 			return err
 		}
 	} else {
@@ -0,0 +1,49 @@
+package extension
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// LoadPersistedQueries adds the queries of a persisted query manifest to an AutomaticPersistedQuery
+// cache, so clients can send the hashes of queries known at build time without registering them first.
+// The manifest is a JSON object of sha256 hashes to queries, eg:
+//
+//	{"30166fc3298853f22709fce1e4a00e98f1b6a3160eaaaf9cb3b7db6a16073b07": "{ name }"}
+//
+// The cache must be large enough to hold the whole manifest, or the evicted queries have to be
+// registered again by the clients.
+func LoadPersistedQueries(ctx context.Context, cache graphql.Cache[string], r io.Reader) error {
+	var manifest map[string]string
+	if err := json.NewDecoder(r).Decode(&manifest); err != nil {
+		return fmt.Errorf("unable to decode persisted query manifest: %w", err)
+	}
+
+	for hash, query := range manifest {
+		if computeQueryHash(query) != hash {
+			return fmt.Errorf("persisted query manifest hash %s does not match its query", hash)
+		}
+	}
+
+	for hash, query := range manifest {
+		cache.Add(ctx, hash, query)
+	}
+	return nil
+}
+
+// LoadPersistedQueriesFile adds the queries of the persisted query manifest in filename to an
+// AutomaticPersistedQuery cache, see LoadPersistedQueries.
+func LoadPersistedQueriesFile(ctx context.Context, cache graphql.Cache[string], filename string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return fmt.Errorf("unable to open persisted query manifest: %w", err)
+	}
+	defer f.Close()
+
+	return LoadPersistedQueries(ctx, cache, f)
+}
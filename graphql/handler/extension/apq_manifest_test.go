@@ -0,0 +1,70 @@
+package extension_test
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+const persistedQueryManifest = `{
+	"30166fc3298853f22709fce1e4a00e98f1b6a3160eaaaf9cb3b7db6a16073b07": "{ name }",
+	"e2ee1c1751641456b993b2aa000a041ab805250f7055a7fc425a9efc839af2ec": "{ find(id: 1) }"
+}`
+
+func TestLoadPersistedQueries(t *testing.T) {
+	t.Run("hash only requests resolve", func(t *testing.T) {
+		filename := filepath.Join(t.TempDir(), "manifest.json")
+		require.NoError(t, os.WriteFile(filename, []byte(persistedQueryManifest), 0o600))
+
+		cache := graphql.MapCache[string]{}
+		require.NoError(t, extension.LoadPersistedQueriesFile(context.Background(), cache, filename))
+		require.Len(t, cache, 2)
+
+		h := testserver.New()
+		h.Use(extension.AutomaticPersistedQuery{Cache: cache})
+		h.AddTransport(&transport.POST{})
+
+		var query string
+		h.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+			query = graphql.GetOperationContext(ctx).RawQuery
+			return next(ctx)
+		})
+
+		resp := doRequest(h, "POST", "/graphql", `{"extensions":{"persistedQuery":{"version":1,"sha256Hash":"30166fc3298853f22709fce1e4a00e98f1b6a3160eaaaf9cb3b7db6a16073b07"}}}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+		require.Equal(t, "{ name }", query)
+
+		resp = doRequest(h, "POST", "/graphql", `{"extensions":{"persistedQuery":{"version":1,"sha256Hash":"e2ee1c1751641456b993b2aa000a041ab805250f7055a7fc425a9efc839af2ec"}}}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+		require.Equal(t, "{ find(id: 1) }", query)
+	})
+
+	t.Run("mismatched hash", func(t *testing.T) {
+		cache := graphql.MapCache[string]{}
+		err := extension.LoadPersistedQueries(context.Background(), cache, strings.NewReader(`{"abc": "{ name }"}`))
+		require.EqualError(t, err, "persisted query manifest hash abc does not match its query")
+		require.Empty(t, cache)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		err := extension.LoadPersistedQueries(context.Background(), graphql.MapCache[string]{}, strings.NewReader(`[]`))
+		require.ErrorContains(t, err, "unable to decode persisted query manifest")
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		err := extension.LoadPersistedQueriesFile(context.Background(), graphql.MapCache[string]{}, filepath.Join(t.TempDir(), "missing.json"))
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -34,6 +35,40 @@ func TestAPQIntegration(t *testing.T) {
 	require.Equal(t, "30166fc3298853f22709fce1e4a00e98f1b6a3160eaaaf9cb3b7db6a16073b07", stats.Hash)
 }
 
+func TestAPQRetryAfter(t *testing.T) {
+	const body = `{"extensions":{"persistedQuery":{"version":1,"sha256Hash":"30166fc3298853f22709fce1e4a00e98f1b6a3160eaaaf9cb3b7db6a16073b07"}}}`
+
+	t.Run("hint is sent on not found when configured", func(t *testing.T) {
+		h := testserver.New()
+		h.Use(extension.AutomaticPersistedQuery{Cache: graphql.MapCache[string]{}}.WithRetryAfter(1500 * time.Millisecond))
+		h.AddTransport(&transport.POST{SetRetryAfterHeader: true})
+
+		resp := doRequest(h, "POST", "/graphql", body)
+		require.Equal(t, "2", resp.Header().Get("Retry-After"))
+		require.JSONEq(t, `{"errors":[{"message":"PersistedQueryNotFound","extensions":{"code":"PERSISTED_QUERY_NOT_FOUND","retryAfter":1.5}}],"data":null}`, resp.Body.String())
+	})
+
+	t.Run("header is only sent when the transport allows it", func(t *testing.T) {
+		h := testserver.New()
+		h.Use(extension.AutomaticPersistedQuery{Cache: graphql.MapCache[string]{}}.WithRetryAfter(time.Second))
+		h.AddTransport(&transport.POST{})
+
+		resp := doRequest(h, "POST", "/graphql", body)
+		require.Empty(t, resp.Header().Get("Retry-After"))
+		require.JSONEq(t, `{"errors":[{"message":"PersistedQueryNotFound","extensions":{"code":"PERSISTED_QUERY_NOT_FOUND","retryAfter":1}}],"data":null}`, resp.Body.String())
+	})
+
+	t.Run("no hint by default", func(t *testing.T) {
+		h := testserver.New()
+		h.Use(&extension.AutomaticPersistedQuery{Cache: graphql.MapCache[string]{}})
+		h.AddTransport(&transport.POST{SetRetryAfterHeader: true})
+
+		resp := doRequest(h, "POST", "/graphql", body)
+		require.Empty(t, resp.Header().Get("Retry-After"))
+		require.JSONEq(t, `{"errors":[{"message":"PersistedQueryNotFound","extensions":{"code":"PERSISTED_QUERY_NOT_FOUND"}}],"data":null}`, resp.Body.String())
+	})
+}
+
 func TestAPQ(t *testing.T) {
 	const query = "{ me { name } }"
 	const hash = "b8d9506e34c83b0e53c2aa463624fcea354713bc38f95276e6f0bd893ffb5b88"
@@ -0,0 +1,144 @@
+package extension
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// CacheControl adds a cacheControl extension to the responses of queries, with the cache policy
+// of the selected fields declared by the cacheControl directive:
+//
+//	enum CacheControlScope { PUBLIC PRIVATE }
+//	directive @cacheControl(maxAge: Int, scope: CacheControlScope) on FIELD_DEFINITION | OBJECT | INTERFACE | UNION
+//
+// The policy has the smallest maxAge and the most restrictive scope of the selected fields. A field
+// without a hint uses the hint on the type it returns, root fields and fields returning objects
+// without either use DefaultMaxAge, while other fields don't restrict the policy. The POST and GET
+// transports can send the policy as a Cache-Control header with SetCacheControlHeader.
+type CacheControl struct {
+	// DefaultMaxAge is the maxAge in seconds of root fields and fields returning objects without a hint.
+	DefaultMaxAge int
+
+	schema *ast.Schema
+}
+
+// CacheControlPolicy is the cache policy of a response.
+type CacheControlPolicy struct {
+	MaxAge int               `json:"maxAge"`
+	Scope  CacheControlScope `json:"scope"`
+}
+
+// CacheControlScope tells whether a response can be stored by shared caches.
+type CacheControlScope string
+
+const (
+	CacheControlScopePublic  CacheControlScope = "PUBLIC"
+	CacheControlScopePrivate CacheControlScope = "PRIVATE"
+)
+
+// HeaderValue returns the Cache-Control header of the policy, eg. "max-age=60, public", or an
+// empty string when the response must not be cached.
+func (p CacheControlPolicy) HeaderValue() string {
+	if p.MaxAge <= 0 {
+		return ""
+	}
+	return "max-age=" + strconv.Itoa(p.MaxAge) + ", " + strings.ToLower(string(p.Scope))
+}
+
+var _ interface {
+	graphql.ResponseInterceptor
+	graphql.HandlerExtension
+} = &CacheControl{}
+
+func (c CacheControl) ExtensionName() string {
+	return "CacheControl"
+}
+
+func (c *CacheControl) Validate(schema graphql.ExecutableSchema) error {
+	if c.DefaultMaxAge < 0 {
+		return errors.New("CacheControl default max age can not be negative")
+	}
+	c.schema = schema.Schema()
+	return nil
+}
+
+func (c *CacheControl) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+
+	opCtx := graphql.GetOperationContext(ctx)
+	if opCtx.Operation == nil || opCtx.Operation.Operation != ast.Query {
+		return next(ctx)
+	}
+
+	maxAge := -1
+	policy := CacheControlPolicy{Scope: CacheControlScopePublic}
+	c.restrict(opCtx.Operation.SelectionSet, true, &maxAge, &policy)
+	policy.MaxAge = max(maxAge, 0)
+
+	graphql.RegisterExtension(ctx, "cacheControl", policy)
+	return next(ctx)
+}
+
+// restrict lowers maxAge to the hints of the fields in set and makes policy private if any of them is.
+// maxAge is -1 until a field restricts it.
+func (c *CacheControl) restrict(set ast.SelectionSet, root bool, maxAge *int, policy *CacheControlPolicy) {
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			if sel.Definition == nil || strings.HasPrefix(sel.Name, "__") {
+				continue
+			}
+
+			hint := sel.Definition.Directives.ForName("cacheControl")
+			typ := c.schema.Types[sel.Definition.Type.Name()]
+			composite := typ != nil && typ.IsCompositeType()
+			if hint == nil && composite {
+				hint = typ.Directives.ForName("cacheControl")
+			}
+
+			fieldMaxAge, ok := hintMaxAge(hint)
+			if !ok && (root || composite) {
+				fieldMaxAge, ok = c.DefaultMaxAge, true
+			}
+			if ok && (*maxAge < 0 || fieldMaxAge < *maxAge) {
+				*maxAge = fieldMaxAge
+			}
+			if hint != nil {
+				if scope := hint.Arguments.ForName("scope"); scope != nil && scope.Value.Raw == string(CacheControlScopePrivate) {
+					policy.Scope = CacheControlScopePrivate
+				}
+			}
+
+			c.restrict(sel.SelectionSet, false, maxAge, policy)
+		case *ast.InlineFragment:
+			c.restrict(sel.SelectionSet, root, maxAge, policy)
+		case *ast.FragmentSpread:
+			if sel.Definition != nil {
+				c.restrict(sel.Definition.SelectionSet, root, maxAge, policy)
+			}
+		}
+	}
+}
+
+func hintMaxAge(hint *ast.Directive) (int, bool) {
+	if hint == nil {
+		return 0, false
+	}
+	arg := hint.Arguments.ForName("maxAge")
+	if arg == nil {
+		return 0, false
+	}
+	maxAge, err := strconv.Atoi(arg.Value.Raw)
+	if err != nil {
+		return 0, false
+	}
+	return maxAge, true
+}
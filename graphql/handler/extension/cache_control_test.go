@@ -0,0 +1,120 @@
+package extension_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/graphqltest"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestCacheControl(t *testing.T) {
+	es := graphqltest.NewMockSchema(`
+		enum CacheControlScope { PUBLIC PRIVATE }
+		directive @cacheControl(maxAge: Int, scope: CacheControlScope) on FIELD_DEFINITION | OBJECT | INTERFACE | UNION
+
+		type Query {
+			news: [Article!]! @cacheControl(maxAge: 60)
+			weather: String @cacheControl(maxAge: 30)
+			version: String
+		}
+		type Mutation { version: String }
+		type Article @cacheControl(maxAge: 120) {
+			title: String!
+			author: User!
+		}
+		type User @cacheControl(maxAge: 10, scope: PRIVATE) {
+			name: String!
+		}
+	`, func(ctx context.Context) graphql.ResponseHandler {
+		return graphql.OneShot(&graphql.Response{Data: []byte(`{}`)})
+	})
+
+	newHandler := func(ext *extension.CacheControl) *handler.Server {
+		h := handler.New(es)
+		h.Use(ext)
+		h.AddTransport(transport.GET{SetCacheControlHeader: true})
+		h.AddTransport(transport.POST{SetCacheControlHeader: true})
+		return h
+	}
+
+	tests := []struct {
+		name          string
+		defaultMaxAge int
+		query         string
+		policy        string
+		header        string
+	}{
+		{
+			name:   "smallest max age of the selected fields",
+			query:  `{ news { title } weather }`,
+			policy: `{"maxAge":30,"scope":"PUBLIC"}`,
+			header: "max-age=30, public",
+		},
+		{
+			name:   "field hints override their type",
+			query:  `{ news { title } }`,
+			policy: `{"maxAge":60,"scope":"PUBLIC"}`,
+			header: "max-age=60, public",
+		},
+		{
+			name:   "fields without hints use the hint of their type",
+			query:  `{ news { title author { name } } }`,
+			policy: `{"maxAge":10,"scope":"PRIVATE"}`,
+			header: "max-age=10, private",
+		},
+		{
+			name:   "root fields without hints are not cached",
+			query:  `{ weather version }`,
+			policy: `{"maxAge":0,"scope":"PUBLIC"}`,
+		},
+		{
+			name:          "root fields without hints use the default",
+			defaultMaxAge: 300,
+			query:         `{ version news { title } }`,
+			policy:        `{"maxAge":60,"scope":"PUBLIC"}`,
+			header:        "max-age=60, public",
+		},
+		{
+			name:   "fragments",
+			query:  `{ ...Weather news { ... on Article { author { name } } } } fragment Weather on Query { weather }`,
+			policy: `{"maxAge":10,"scope":"PRIVATE"}`,
+			header: "max-age=10, private",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHandler(&extension.CacheControl{DefaultMaxAge: tc.defaultMaxAge})
+
+			body, err := json.Marshal(map[string]string{"query": tc.query})
+			require.NoError(t, err)
+
+			resp := doRequest(h, "POST", "/graphql", string(body))
+			require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+			require.JSONEq(t, `{"data":{},"extensions":{"cacheControl":`+tc.policy+`}}`, resp.Body.String())
+			require.Equal(t, tc.header, resp.Header().Get("Cache-Control"))
+
+			resp = doRequest(h, "GET", "/graphql?query="+url.QueryEscape(tc.query), "")
+			require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+			require.Equal(t, tc.header, resp.Header().Get("Cache-Control"))
+		})
+	}
+
+	t.Run("mutations have no policy", func(t *testing.T) {
+		h := newHandler(&extension.CacheControl{DefaultMaxAge: 300})
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"mutation { version }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"data":{}}`, resp.Body.String())
+		require.Empty(t, resp.Header().Get("Cache-Control"))
+	})
+}
@@ -0,0 +1,106 @@
+package extension
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// CanonicalOperation prints the executed operation, along with the fragments it uses, in a canonical
+// form and hashes it, so operations can be grouped in logs regardless of how the client formatted
+// them, the other operations of the document or the values of the variables. Read them with
+// GetCanonicalOperation, eg. from an AroundOperations middleware.
+type CanonicalOperation struct{}
+
+type CanonicalOperationStats struct {
+	// Query is the operation printed in its canonical form.
+	Query string
+
+	// Hash is the hex encoded sha256 hash of Query.
+	Hash string
+}
+
+const canonicalOperationExtension = "CanonicalOperation"
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = CanonicalOperation{}
+
+func (c CanonicalOperation) ExtensionName() string {
+	return canonicalOperationExtension
+}
+
+func (c CanonicalOperation) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (c CanonicalOperation) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	doc := &ast.QueryDocument{
+		Operations: ast.OperationList{opCtx.Operation},
+		Fragments:  usedFragments(opCtx.Doc, opCtx.Operation),
+	}
+
+	var buf bytes.Buffer
+	formatter.NewFormatter(&buf).FormatQueryDocument(doc)
+	hash := sha256.Sum256(buf.Bytes())
+
+	opCtx.Stats.SetExtension(canonicalOperationExtension, &CanonicalOperationStats{
+		Query: buf.String(),
+		Hash:  hex.EncodeToString(hash[:]),
+	})
+	return nil
+}
+
+// GetCanonicalOperation returns the canonical form of the current operation computed by
+// CanonicalOperation.
+func GetCanonicalOperation(ctx context.Context) *CanonicalOperationStats {
+	if !graphql.HasOperationContext(ctx) {
+		return nil
+	}
+
+	s, _ := graphql.GetOperationContext(ctx).Stats.GetExtension(canonicalOperationExtension).(*CanonicalOperationStats)
+	return s
+}
+
+// usedFragments returns the fragments of doc spread by op, directly or through other fragments,
+// sorted by name.
+func usedFragments(doc *ast.QueryDocument, op *ast.OperationDefinition) ast.FragmentDefinitionList {
+	used := map[string]*ast.FragmentDefinition{}
+
+	var walk func(set ast.SelectionSet)
+	walk = func(set ast.SelectionSet) {
+		for _, sel := range set {
+			switch sel := sel.(type) {
+			case *ast.Field:
+				walk(sel.SelectionSet)
+			case *ast.InlineFragment:
+				walk(sel.SelectionSet)
+			case *ast.FragmentSpread:
+				if _, ok := used[sel.Name]; ok {
+					continue
+				}
+				if def := doc.Fragments.ForName(sel.Name); def != nil {
+					used[sel.Name] = def
+					walk(def.SelectionSet)
+				}
+			}
+		}
+	}
+	walk(op.SelectionSet)
+
+	fragments := make(ast.FragmentDefinitionList, 0, len(used))
+	for _, def := range used {
+		fragments = append(fragments, def)
+	}
+	sort.Slice(fragments, func(i, j int) bool { return fragments[i].Name < fragments[j].Name })
+	return fragments
+}
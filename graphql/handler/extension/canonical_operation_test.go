@@ -0,0 +1,49 @@
+package extension_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestCanonicalOperation(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(&transport.POST{})
+	h.Use(extension.CanonicalOperation{})
+
+	var stats *extension.CanonicalOperationStats
+	h.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		stats = extension.GetCanonicalOperation(ctx)
+		return next(ctx)
+	})
+
+	canonical := func(t *testing.T, body string) *extension.CanonicalOperationStats {
+		stats = nil
+		resp := doRequest(h, "POST", "/graphql", body)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.NotNil(t, stats)
+		return stats
+	}
+
+	first := canonical(t, `{"query":"query Find($id: Int!) { find(id: $id) ...names }\nfragment names on Query { name oldName }","operationName":"Find","variables":{"id":1}}`)
+	require.Equal(t, "query Find ($id: Int!) {\n\tfind(id: $id)\n\t... names\n}\nfragment names on Query {\n\tname\n\toldName\n}\n", first.Query)
+	require.Len(t, first.Hash, 64)
+
+	t.Run("formatting and other operations don't change the hash", func(t *testing.T) {
+		second := canonical(t, `{"query":"query Other { ...tagged }\nfragment tagged on Query { tags }\n\nfragment names on Query {\n  name\n  oldName\n}\n\nquery   Find( $id:Int! ){\n  find( id:$id )\n  ...names\n}","operationName":"Find","variables":{"id":2}}`)
+		require.Equal(t, first.Query, second.Query)
+		require.Equal(t, first.Hash, second.Hash)
+	})
+
+	t.Run("different operations have different hashes", func(t *testing.T) {
+		other := canonical(t, `{"query":"query Find($id: Int!) { find(id: $id) name }","operationName":"Find","variables":{"id":1}}`)
+		require.NotEqual(t, first.Hash, other.Hash)
+	})
+}
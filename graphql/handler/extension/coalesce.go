@@ -0,0 +1,106 @@
+package extension
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// CoalesceQueries executes identical queries that are in flight at the same time only once, and sends
+// the result to every client that asked for it. Mutations, subscriptions and queries with deferred
+// fragments are never coalesced.
+//
+// The shared execution runs with the context of the first request, so queries are coalesced by the key
+// returned by KeyFunc. ResponseCacheKey only identifies the operation, so when results depend on values
+// of the request context, such as the authenticated user, KeyFunc must add them to it, or return an
+// empty key to execute the query on its own, eg:
+//
+//	KeyFunc: func(ctx context.Context) string {
+//		return auth.UserFromContext(ctx).ID + ":" + extension.ResponseCacheKey(ctx)
+//	}
+type CoalesceQueries struct {
+	// KeyFunc returns the key identical queries are coalesced by, an empty key disables coalescing. It
+	// is required, so that sharing results between callers is always a deliberate choice.
+	KeyFunc func(ctx context.Context) string
+
+	group singleflight.Group
+}
+
+var _ interface {
+	graphql.ResponseInterceptor
+	graphql.HandlerExtension
+} = &CoalesceQueries{}
+
+func (c *CoalesceQueries) ExtensionName() string {
+	return "CoalesceQueries"
+}
+
+func (c *CoalesceQueries) Validate(schema graphql.ExecutableSchema) error {
+	if c.KeyFunc == nil {
+		return errors.New("CoalesceQueries.KeyFunc can not be nil")
+	}
+	return nil
+}
+
+func (c *CoalesceQueries) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+
+	opCtx := graphql.GetOperationContext(ctx)
+	// deferred fragments are sent by later calls to the response handler of each client, so they
+	// can't be shared.
+	if opCtx.Operation == nil || opCtx.Operation.Operation != ast.Query || hasDefer(opCtx.Operation.SelectionSet) {
+		return next(ctx)
+	}
+
+	key := c.KeyFunc(ctx)
+	if key == "" {
+		return next(ctx)
+	}
+
+	res, _, shared := c.group.Do(key, func() (any, error) {
+		// the other clients are waiting on this execution too, so it must not be cancelled when the
+		// first client goes away.
+		return next(context.WithoutCancel(ctx)), nil
+	})
+	resp, _ := res.(*graphql.Response)
+	if !shared || resp == nil {
+		return resp
+	}
+
+	// every client gets its own copy, so the response middleware wrapping this one can change it.
+	return &graphql.Response{
+		Errors:     slices.Clone(resp.Errors),
+		Data:       resp.Data,
+		Extensions: graphql.GetExtensions(ctx),
+	}
+}
+
+func hasDefer(selections ast.SelectionSet) bool {
+	for _, sel := range selections {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			if hasDefer(sel.SelectionSet) {
+				return true
+			}
+		case *ast.InlineFragment:
+			if sel.Directives.ForName("defer") != nil || hasDefer(sel.SelectionSet) {
+				return true
+			}
+		case *ast.FragmentSpread:
+			if sel.Directives.ForName("defer") != nil {
+				return true
+			}
+			if sel.Definition != nil && hasDefer(sel.Definition.SelectionSet) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+package extension_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestCoalesceQueries(t *testing.T) {
+	const clients = 10
+
+	// executions block until every client has sent its request, so identical queries all join the
+	// same execution.
+	newServer := func(keyFunc func(ctx context.Context) string) (*testserver.TestServer, *atomic.Int32) {
+		var arrived, executed atomic.Int32
+		allArrived := make(chan struct{})
+
+		h := testserver.New()
+		h.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+			if arrived.Add(1) == clients {
+				close(allArrived)
+			}
+			return next(ctx)
+		})
+		h.Use(&extension.CoalesceQueries{KeyFunc: keyFunc})
+		h.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+			executed.Add(1)
+			<-allArrived
+			time.Sleep(10 * time.Millisecond)
+			return next(ctx)
+		})
+		h.AddTransport(&transport.POST{})
+		return h, &executed
+	}
+
+	run := func(h http.Handler, request func(i int) *http.Request) []*httptest.ResponseRecorder {
+		var wg sync.WaitGroup
+		responses := make([]*httptest.ResponseRecorder, clients)
+		for i := range clients {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				responses[i] = httptest.NewRecorder()
+				h.ServeHTTP(responses[i], request(i))
+			}()
+		}
+		wg.Wait()
+		return responses
+	}
+
+	post := func(body string) *http.Request {
+		r := httptest.NewRequest("POST", "/graphql", strings.NewReader(body))
+		r.Header.Set("Content-Type", "application/json")
+		return r
+	}
+
+	t.Run("key func is required", func(t *testing.T) {
+		require.PanicsWithError(t, "CoalesceQueries.KeyFunc can not be nil", func() {
+			testserver.New().Use(&extension.CoalesceQueries{})
+		})
+	})
+
+	t.Run("identical queries execute once", func(t *testing.T) {
+		h, executed := newServer(extension.ResponseCacheKey)
+
+		responses := run(h, func(int) *http.Request { return post(`{"query":"{ name }"}`) })
+		for _, resp := range responses {
+			require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+			require.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+		}
+		require.EqualValues(t, 1, executed.Load())
+	})
+
+	t.Run("different variables execute separately", func(t *testing.T) {
+		h, executed := newServer(extension.ResponseCacheKey)
+
+		responses := run(h, func(i int) *http.Request {
+			return post(`{"query":"query($id: Int!) { find(id: $id) }","variables":{"id":` + strconv.Itoa(i) + `}}`)
+		})
+		for _, resp := range responses {
+			require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		}
+		require.EqualValues(t, clients, executed.Load())
+	})
+
+	t.Run("mutations execute separately", func(t *testing.T) {
+		h, executed := newServer(extension.ResponseCacheKey)
+
+		responses := run(h, func(int) *http.Request { return post(`{"query":"mutation { name }"}`) })
+		for _, resp := range responses {
+			require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		}
+		require.EqualValues(t, clients, executed.Load())
+	})
+	t.Run("key func scopes coalescing", func(t *testing.T) {
+		h, executed := newServer(func(ctx context.Context) string {
+			return graphql.GetOperationContext(ctx).Headers.Get("Authorization") + ":" + extension.ResponseCacheKey(ctx)
+		})
+		// resolves name to the caller, so that results differ between them
+		h.AroundFields(func(ctx context.Context, next graphql.Resolver) (res any, err error) {
+			if _, err := next(ctx); err != nil {
+				return nil, err
+			}
+			name, _ := json.Marshal(graphql.GetOperationContext(ctx).Headers.Get("Authorization"))
+			return &graphql.Response{Data: []byte(`{"name":` + string(name) + `}`)}, nil
+		})
+
+		users := []string{"alice", "bob"}
+		responses := run(h, func(i int) *http.Request {
+			r := post(`{"query":"{ name }"}`)
+			r.Header.Set("Authorization", users[i%2])
+			return r
+		})
+		for i, resp := range responses {
+			require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+			require.JSONEq(t, `{"data":{"name":"`+users[i%2]+`"}}`, resp.Body.String())
+		}
+		require.EqualValues(t, 2, executed.Load())
+	})
+}
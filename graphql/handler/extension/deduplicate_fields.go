@@ -0,0 +1,110 @@
+package extension
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// DeduplicateFields runs the resolver of a field selected more than once in the same selection set,
+// eg. under different aliases, only once when the selections have the same arguments and directives.
+// Every alias gets the shared result marshaled with its own sub-selection.
+//
+// Only fields backed by a resolver or a method are deduplicated, and root mutation fields never are, as
+// they are expected to have side effects.
+type DeduplicateFields struct{}
+
+var _ interface {
+	graphql.OperationInterceptor
+	graphql.FieldInterceptor
+	graphql.HandlerExtension
+} = DeduplicateFields{}
+
+type dedupedFieldsKey struct{}
+
+type dedupedFields struct {
+	mu      sync.Mutex
+	results map[string]*dedupedField
+}
+
+type dedupedField struct {
+	done chan struct{}
+	res  any
+	err  error
+}
+
+func (d DeduplicateFields) ExtensionName() string {
+	return "DeduplicateFields"
+}
+
+func (d DeduplicateFields) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (d DeduplicateFields) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	return next(context.WithValue(ctx, dedupedFieldsKey{}, &dedupedFields{results: map[string]*dedupedField{}}))
+}
+
+func (d DeduplicateFields) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	fields, ok := ctx.Value(dedupedFieldsKey{}).(*dedupedFields)
+	fc := graphql.GetFieldContext(ctx)
+	if !ok || fc == nil || !(fc.IsResolver || fc.IsMethod) {
+		return next(ctx)
+	}
+	if fc.Parent == nil && graphql.GetOperationContext(ctx).Operation.Operation == ast.Mutation {
+		return next(ctx)
+	}
+
+	key, ok := dedupeKey(fc)
+	if !ok {
+		return next(ctx)
+	}
+
+	fields.mu.Lock()
+	field, resolving := fields.results[key]
+	if !resolving {
+		field = &dedupedField{done: make(chan struct{})}
+		fields.results[key] = field
+	}
+	fields.mu.Unlock()
+
+	if resolving {
+		<-field.done
+		return field.res, field.err
+	}
+
+	// if next panics the other selections get this error, the panic itself is reported on this one
+	field.err = errors.New("the field failed to resolve")
+	defer close(field.done)
+	field.res, field.err = next(ctx)
+	return field.res, field.err
+}
+
+// dedupeKey identifies a field by the selection set it is in, its name, arguments and directives, it
+// returns false when the arguments can not be compared.
+func dedupeKey(fc *graphql.FieldContext) (string, bool) {
+	args, err := json.Marshal(fc.Args)
+	if err != nil {
+		return "", false
+	}
+
+	var key strings.Builder
+	if fc.Parent != nil {
+		key.WriteString(fc.Parent.Path().String())
+	}
+	key.WriteString("|" + fc.Object + "." + fc.Field.Name + "|")
+	key.Write(args)
+	for _, directive := range fc.Field.Directives {
+		key.WriteString("|@" + directive.Name)
+		for _, arg := range directive.Arguments {
+			key.WriteString(" " + arg.Name + ":" + arg.Value.String())
+		}
+	}
+	return key.String(), true
+}
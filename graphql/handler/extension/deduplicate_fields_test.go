@@ -0,0 +1,105 @@
+package extension_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/graphqltest"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestDeduplicateFields(t *testing.T) {
+	var calls atomic.Int32
+	es := graphqltest.NewMockSchema(`
+		type Query { search(text: String!): String! }
+		type Mutation { search(text: String!): String! }
+	`, func(ctx context.Context) graphql.ResponseHandler {
+		ran := false
+		return func(ctx context.Context) *graphql.Response {
+			if ran {
+				return nil
+			}
+			ran = true
+
+			// mimic the generated code resolving the root fields concurrently
+			opCtx := graphql.GetOperationContext(ctx)
+			fields := graphql.CollectFields(opCtx, opCtx.Operation.SelectionSet, []string{"Query", "Mutation"})
+			data := make(map[string]any, len(fields))
+			var mu sync.Mutex
+			var wg sync.WaitGroup
+			for _, field := range fields {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					fctx := graphql.WithFieldContext(ctx, &graphql.FieldContext{
+						Object:     "Query",
+						Field:      field,
+						Args:       field.ArgumentMap(opCtx.Variables),
+						IsResolver: true,
+					})
+					res, err := opCtx.ResolverMiddleware(fctx, func(ctx context.Context) (any, error) {
+						n := calls.Add(1)
+						return fmt.Sprintf("%s #%d", graphql.GetFieldContext(ctx).Args["text"], n), nil
+					})
+					require.NoError(t, err)
+					mu.Lock()
+					data[field.Alias] = res
+					mu.Unlock()
+				}()
+			}
+			wg.Wait()
+
+			b, err := json.Marshal(data)
+			require.NoError(t, err)
+			return &graphql.Response{Data: b}
+		}
+	})
+
+	h := handler.New(es)
+	h.Use(extension.DeduplicateFields{})
+	h.AddTransport(&transport.POST{})
+
+	t.Run("aliases with identical arguments resolve once", func(t *testing.T) {
+		calls.Store(0)
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ a: search(text: \"x\") b: search(text: \"x\") }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"data":{"a":"x #1","b":"x #1"}}`, resp.Body.String())
+		require.EqualValues(t, 1, calls.Load())
+	})
+
+	t.Run("results are not shared across operations", func(t *testing.T) {
+		calls.Store(0)
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ a: search(text: \"x\") }"}`)
+		require.JSONEq(t, `{"data":{"a":"x #1"}}`, resp.Body.String())
+		resp = doRequest(h, "POST", "/graphql", `{"query":"{ a: search(text: \"x\") }"}`)
+		require.JSONEq(t, `{"data":{"a":"x #2"}}`, resp.Body.String())
+	})
+
+	t.Run("different arguments resolve separately", func(t *testing.T) {
+		calls.Store(0)
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ a: search(text: \"x\") b: search(text: \"y\") }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.EqualValues(t, 2, calls.Load())
+	})
+
+	t.Run("mutation root fields are not deduplicated", func(t *testing.T) {
+		calls.Store(0)
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"mutation { a: search(text: \"x\") b: search(text: \"x\") }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.EqualValues(t, 2, calls.Load())
+	})
+}
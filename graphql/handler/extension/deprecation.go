@@ -0,0 +1,203 @@
+package extension
+
+import (
+	"context"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+const (
+	errDeprecatedUsage   = "DEPRECATED_USAGE"
+	deprecationExtension = "DeprecationCheck"
+)
+
+type DeprecationMode int
+
+const (
+	// DeprecationWarn executes the operation and lists the deprecated usages in the "deprecations"
+	// response extension.
+	DeprecationWarn DeprecationMode = iota
+	// DeprecationReject fails any operation that selects a deprecated field or uses a deprecated
+	// enum value.
+	DeprecationReject
+)
+
+// DeprecationCheck looks for @deprecated fields and enum values used by an operation, to help
+// enforce the removal of deprecated parts of the schema.
+type DeprecationCheck struct {
+	Mode DeprecationMode
+
+	schema *ast.Schema
+}
+
+// Deprecation describes a single deprecated field or enum value used by an operation.
+type Deprecation struct {
+	// Coordinate is the schema coordinate of the deprecated element, eg "Query.oldField" or "Color.RED".
+	Coordinate string `json:"coordinate"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.ResponseInterceptor
+	graphql.HandlerExtension
+} = &DeprecationCheck{}
+
+func (d DeprecationCheck) ExtensionName() string {
+	return deprecationExtension
+}
+
+func (d *DeprecationCheck) Validate(schema graphql.ExecutableSchema) error {
+	d.schema = schema.Schema()
+	return nil
+}
+
+func (d *DeprecationCheck) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	c := &deprecationCollector{schema: d.schema, seen: map[string]bool{}, fragments: map[string]bool{}}
+	c.selectionSet(opCtx.Operation.SelectionSet)
+	for _, v := range opCtx.Operation.VariableDefinitions {
+		c.value(v.DefaultValue)
+		if value, ok := opCtx.Variables[v.Variable]; ok {
+			c.variable(v.Type, value)
+		}
+	}
+
+	if len(c.found) == 0 {
+		return nil
+	}
+
+	if d.Mode == DeprecationReject {
+		coordinates := make([]string, 0, len(c.found))
+		for _, dep := range c.found {
+			coordinates = append(coordinates, dep.Coordinate)
+		}
+		err := gqlerror.Errorf("operation uses deprecated %s", strings.Join(coordinates, ", "))
+		errcode.Set(err, errDeprecatedUsage)
+		return err
+	}
+
+	opCtx.Stats.SetExtension(deprecationExtension, c.found)
+	return nil
+}
+
+func (d DeprecationCheck) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if deprecations := GetDeprecations(ctx); len(deprecations) != 0 {
+		graphql.RegisterExtension(ctx, "deprecations", deprecations)
+	}
+	return next(ctx)
+}
+
+// GetDeprecations returns the deprecated usages found in the current operation by DeprecationCheck.
+func GetDeprecations(ctx context.Context) []Deprecation {
+	if !graphql.HasOperationContext(ctx) {
+		return nil
+	}
+
+	s, _ := graphql.GetOperationContext(ctx).Stats.GetExtension(deprecationExtension).([]Deprecation)
+	return s
+}
+
+type deprecationCollector struct {
+	schema    *ast.Schema
+	found     []Deprecation
+	seen      map[string]bool
+	fragments map[string]bool
+}
+
+func (c *deprecationCollector) add(coordinate string, directives ast.DirectiveList) {
+	d := directives.ForName("deprecated")
+	if d == nil || c.seen[coordinate] {
+		return
+	}
+	c.seen[coordinate] = true
+
+	dep := Deprecation{Coordinate: coordinate}
+	if reason := d.Arguments.ForName("reason"); reason != nil && reason.Value != nil {
+		dep.Reason = reason.Value.Raw
+	}
+	c.found = append(c.found, dep)
+}
+
+func (c *deprecationCollector) selectionSet(set ast.SelectionSet) {
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			if sel.Definition != nil && sel.ObjectDefinition != nil {
+				c.add(sel.ObjectDefinition.Name+"."+sel.Name, sel.Definition.Directives)
+			}
+			for _, arg := range sel.Arguments {
+				c.value(arg.Value)
+			}
+			c.selectionSet(sel.SelectionSet)
+		case *ast.InlineFragment:
+			c.selectionSet(sel.SelectionSet)
+		case *ast.FragmentSpread:
+			if sel.Definition == nil || c.fragments[sel.Name] {
+				continue
+			}
+			c.fragments[sel.Name] = true
+			c.selectionSet(sel.Definition.SelectionSet)
+		}
+	}
+}
+
+func (c *deprecationCollector) value(v *ast.Value) {
+	if v == nil {
+		return
+	}
+	if v.Kind == ast.EnumValue && v.Definition != nil {
+		if ev := v.Definition.EnumValues.ForName(v.Raw); ev != nil {
+			c.add(v.Definition.Name+"."+v.Raw, ev.Directives)
+		}
+	}
+	for _, child := range v.Children {
+		c.value(child.Value)
+	}
+}
+
+// variable looks for deprecated enum values in value, the coerced value of a variable of type typ.
+func (c *deprecationCollector) variable(typ *ast.Type, value any) {
+	if value == nil {
+		return
+	}
+	if typ.Elem != nil {
+		list, ok := value.([]any)
+		if !ok {
+			// a single value is coerced to a list of one
+			c.variable(typ.Elem, value)
+			return
+		}
+		for _, v := range list {
+			c.variable(typ.Elem, v)
+		}
+		return
+	}
+
+	def := c.schema.Types[typ.NamedType]
+	if def == nil {
+		return
+	}
+	switch def.Kind {
+	case ast.Enum:
+		if raw, ok := value.(string); ok {
+			if ev := def.EnumValues.ForName(raw); ev != nil {
+				c.add(def.Name+"."+raw, ev.Directives)
+			}
+		}
+	case ast.InputObject:
+		fields, ok := value.(map[string]any)
+		if !ok {
+			return
+		}
+		for _, f := range def.Fields {
+			if v, ok := fields[f.Name]; ok {
+				c.variable(f.Type, v)
+			}
+		}
+	}
+}
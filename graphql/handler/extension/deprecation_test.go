@@ -0,0 +1,90 @@
+package extension_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestDeprecationCheck(t *testing.T) {
+	t.Run("warn", func(t *testing.T) {
+		h := testserver.New()
+		h.Use(&extension.DeprecationCheck{Mode: extension.DeprecationWarn})
+		h.AddTransport(&transport.POST{})
+
+		var deprecations []extension.Deprecation
+		h.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+			deprecations = extension.GetDeprecations(ctx)
+			return next(ctx)
+		})
+
+		t.Run("no deprecated usage", func(t *testing.T) {
+			resp := doRequest(h, "POST", "/graphql", `{"query":"{ name greet(style: FORMAL) }"}`)
+			require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+			require.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+			require.Empty(t, deprecations)
+		})
+
+		t.Run("deprecated field", func(t *testing.T) {
+			resp := doRequest(h, "POST", "/graphql", `{"query":"{ name oldName }"}`)
+			require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+			require.JSONEq(t, `{"data":{"name":"test"},"extensions":{"deprecations":[{"coordinate":"Query.oldName","reason":"use name"}]}}`, resp.Body.String())
+			require.Equal(t, []extension.Deprecation{{Coordinate: "Query.oldName", Reason: "use name"}}, deprecations)
+		})
+
+		t.Run("deprecated enum value inside a fragment", func(t *testing.T) {
+			resp := doRequest(h, "POST", "/graphql", `{"query":"{ ...F } fragment F on Query { greet(style: CASUAL) }"}`)
+			require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+			require.JSONEq(t, `{"data":{"name":"test"},"extensions":{"deprecations":[{"coordinate":"Style.CASUAL","reason":"too informal"}]}}`, resp.Body.String())
+		})
+
+		t.Run("deprecated enum value passed as a variable", func(t *testing.T) {
+			resp := doRequest(h, "POST", "/graphql", `{"query":"query($style: Style) { greet(style: $style) }","variables":{"style":"CASUAL"}}`)
+			require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+			require.JSONEq(t, `{"data":{"name":"test"},"extensions":{"deprecations":[{"coordinate":"Style.CASUAL","reason":"too informal"}]}}`, resp.Body.String())
+		})
+
+		t.Run("enum value passed as a variable", func(t *testing.T) {
+			resp := doRequest(h, "POST", "/graphql", `{"query":"query($style: Style) { greet(style: $style) }","variables":{"style":"FORMAL"}}`)
+			require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+			require.Empty(t, deprecations)
+		})
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		h := testserver.New()
+		h.Use(&extension.DeprecationCheck{Mode: extension.DeprecationReject})
+		h.AddTransport(&transport.POST{})
+
+		t.Run("no deprecated usage", func(t *testing.T) {
+			resp := doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`)
+			require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+			require.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+		})
+
+		t.Run("deprecated field", func(t *testing.T) {
+			resp := doRequest(h, "POST", "/graphql", `{"query":"{ name oldName }"}`)
+			require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+			require.JSONEq(t, `{"errors":[{"message":"operation uses deprecated Query.oldName","extensions":{"code":"DEPRECATED_USAGE"}}],"data":null}`, resp.Body.String())
+		})
+
+		t.Run("deprecated field and enum value", func(t *testing.T) {
+			resp := doRequest(h, "POST", "/graphql", `{"query":"{ oldName greet(style: CASUAL) }"}`)
+			require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+			require.JSONEq(t, `{"errors":[{"message":"operation uses deprecated Query.oldName, Style.CASUAL","extensions":{"code":"DEPRECATED_USAGE"}}],"data":null}`, resp.Body.String())
+		})
+
+		t.Run("deprecated enum value passed as a variable", func(t *testing.T) {
+			resp := doRequest(h, "POST", "/graphql", `{"query":"query($style: Style) { greet(style: $style) }","variables":{"style":"CASUAL"}}`)
+			require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+			require.JSONEq(t, `{"errors":[{"message":"operation uses deprecated Style.CASUAL","extensions":{"code":"DEPRECATED_USAGE"}}],"data":null}`, resp.Body.String())
+		})
+	})
+}
@@ -0,0 +1,37 @@
+package extension
+
+import (
+	"context"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// FieldErrorDuration times field resolvers and adds how long the ones that fail ran for, in
+// milliseconds, to the durationMs extension of their error, to help correlate errors with slow
+// resolvers.
+type FieldErrorDuration struct{}
+
+var _ interface {
+	graphql.FieldInterceptor
+	graphql.HandlerExtension
+} = FieldErrorDuration{}
+
+func (f FieldErrorDuration) ExtensionName() string {
+	return "FieldErrorDuration"
+}
+
+func (f FieldErrorDuration) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (f FieldErrorDuration) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	start := graphql.Now()
+	res, err := next(ctx)
+	if err == nil {
+		return res, nil
+	}
+
+	// each error joined in err reports the duration, see graphql.WithExtension
+	return res, graphql.WithExtension(err, "durationMs", float64(graphql.Now().Sub(start))/float64(time.Millisecond))
+}
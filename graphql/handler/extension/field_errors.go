@@ -0,0 +1,51 @@
+package extension
+
+import (
+	"context"
+	"errors"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// FieldErrorCounter reports how many errors were returned for each field path, to help find the
+// resolvers that fail most often.
+//
+// Func is called once per response with the error counts keyed by field path, eg "users[0].name".
+// Errors that are not attached to a field, such as validation errors, are not counted.
+type FieldErrorCounter struct {
+	Func func(ctx context.Context, counts map[string]int)
+}
+
+var _ interface {
+	graphql.ResponseInterceptor
+	graphql.HandlerExtension
+} = FieldErrorCounter{}
+
+func (c FieldErrorCounter) ExtensionName() string {
+	return "FieldErrorCounter"
+}
+
+func (c FieldErrorCounter) Validate(schema graphql.ExecutableSchema) error {
+	if c.Func == nil {
+		return errors.New("FieldErrorCounter func can not be nil")
+	}
+	return nil
+}
+
+func (c FieldErrorCounter) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	resp := next(ctx)
+	if resp == nil {
+		return nil
+	}
+
+	counts := map[string]int{}
+	for _, err := range resp.Errors {
+		if len(err.Path) == 0 {
+			continue
+		}
+		counts[err.Path.String()]++
+	}
+	c.Func(ctx, counts)
+
+	return resp
+}
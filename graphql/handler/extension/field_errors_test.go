@@ -0,0 +1,56 @@
+package extension_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestFieldErrorCounter(t *testing.T) {
+	var counts map[string]int
+
+	h := testserver.New()
+	h.Use(extension.FieldErrorCounter{
+		Func: func(ctx context.Context, c map[string]int) {
+			counts = c
+		},
+	})
+	h.AddTransport(&transport.POST{})
+
+	t.Run("counts errors per field path", func(t *testing.T) {
+		h.AroundFields(func(ctx context.Context, next graphql.Resolver) (any, error) {
+			graphql.AddError(ctx, errors.New("name failed"))
+			graphql.AddError(ctx, errors.New("name failed again"))
+			graphql.AddError(ctx, &gqlerror.Error{
+				Message: "friend failed",
+				Path:    ast.Path{ast.PathName("friends"), ast.PathIndex(0), ast.PathName("name")},
+			})
+			return next(ctx)
+		})
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.Equal(t, map[string]int{
+			"name":            2,
+			"friends[0].name": 1,
+		}, counts)
+	})
+
+	t.Run("ignores errors without a path", func(t *testing.T) {
+		counts = nil
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ unknown }"}`)
+		require.Equal(t, http.StatusUnprocessableEntity, resp.Code, resp.Body.String())
+		require.Empty(t, counts)
+		require.NotNil(t, counts)
+	})
+}
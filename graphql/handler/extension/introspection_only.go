@@ -0,0 +1,42 @@
+package extension
+
+import (
+	"context"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+const errIntrospectionOnly = "INTROSPECTION_ONLY"
+
+// IntrospectionOnly turns the server into a read-only view of its schema, eg. for a schema registry:
+// introspection is enabled, and any operation other than a query selecting only __schema, __type or
+// __typename is rejected. It doesn't need the Introspection extension.
+type IntrospectionOnly struct{}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = IntrospectionOnly{}
+
+func (c IntrospectionOnly) ExtensionName() string {
+	return "IntrospectionOnly"
+}
+
+func (c IntrospectionOnly) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (c IntrospectionOnly) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	if _, data := selectedFields(opCtx.Operation.SelectionSet); data || opCtx.Operation.Operation != ast.Query {
+		err := gqlerror.Errorf("this server only answers introspection queries")
+		errcode.Set(err, errIntrospectionOnly)
+		return err
+	}
+
+	opCtx.DisableIntrospection = false
+	return nil
+}
@@ -0,0 +1,51 @@
+package extension_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestIntrospectionOnly(t *testing.T) {
+	h := testserver.New()
+	h.Use(extension.IntrospectionOnly{})
+	h.AddTransport(&transport.POST{})
+
+	var introspectionDisabled bool
+	h.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		introspectionDisabled = graphql.GetOperationContext(ctx).DisableIntrospection
+		return next(ctx)
+	})
+
+	t.Run("introspection", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ __typename __schema { queryType { name } } __type(name: \"Query\") { name } }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.NotContains(t, resp.Body.String(), "errors")
+		require.False(t, introspectionDisabled)
+	})
+
+	t.Run("data query", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"errors":[{"message":"this server only answers introspection queries","extensions":{"code":"INTROSPECTION_ONLY"}}],"data":null}`, resp.Body.String())
+	})
+
+	t.Run("data mixed with introspection through fragments", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"query { __schema { queryType { name } } ...Data } fragment Data on Query { name }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"errors":[{"message":"this server only answers introspection queries","extensions":{"code":"INTROSPECTION_ONLY"}}],"data":null}`, resp.Body.String())
+	})
+
+	t.Run("mutation", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"mutation { __typename }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"errors":[{"message":"this server only answers introspection queries","extensions":{"code":"INTROSPECTION_ONLY"}}],"data":null}`, resp.Body.String())
+	})
+}
@@ -0,0 +1,40 @@
+package extension
+
+import (
+	"context"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+const errOperationNameRequired = "OPERATION_NAME_REQUIRED"
+
+// RequireOperationName rejects anonymous operations, including documents that only contain a
+// single anonymous operation such as "{ users { id } }", so every operation can be attributed
+// to a name in logs and metrics.
+type RequireOperationName struct{}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = RequireOperationName{}
+
+func (r RequireOperationName) ExtensionName() string {
+	return "RequireOperationName"
+}
+
+func (r RequireOperationName) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (r RequireOperationName) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	if opCtx.Operation.Name != "" {
+		return nil
+	}
+
+	err := gqlerror.Errorf("operations must be named")
+	errcode.Set(err, errOperationNameRequired)
+	return err
+}
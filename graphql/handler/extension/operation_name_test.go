@@ -0,0 +1,42 @@
+package extension_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestRequireOperationName(t *testing.T) {
+	h := testserver.New()
+	h.Use(extension.RequireOperationName{})
+	h.AddTransport(&transport.POST{})
+
+	t.Run("named operation", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"query Name { name }","operationName":"Name"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+	})
+
+	t.Run("named operation without operationName", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"query Name { name }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+	})
+
+	t.Run("anonymous operation", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"query { name }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"errors":[{"message":"operations must be named","extensions":{"code":"OPERATION_NAME_REQUIRED"}}],"data":null}`, resp.Body.String())
+	})
+
+	t.Run("anonymous query shorthand", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"errors":[{"message":"operations must be named","extensions":{"code":"OPERATION_NAME_REQUIRED"}}],"data":null}`, resp.Body.String())
+	})
+}
@@ -0,0 +1,102 @@
+package extension
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+const errOperationTimeout = "OPERATION_TIMEOUT"
+
+// OperationTimeout sets a deadline on the context of queries and mutations, so resolvers that
+// respect it stop once the operation has run for too long.
+//
+// An operation can set its own limit with the maxDuration directive, eg.
+// "query Foo @maxDuration(ms: 200) { ... }", which overrides Default for that operation only. The
+// schema must declare the directive to use it:
+//
+//	directive @maxDuration(ms: Int!) on QUERY | MUTATION
+//
+// Operations that run past their deadline get an error with the OPERATION_TIMEOUT code added to
+// their response. Subscriptions are never limited.
+type OperationTimeout struct {
+	// Default applies to operations without the maxDuration directive, zero means no limit.
+	Default time.Duration
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.OperationInterceptor
+	graphql.HandlerExtension
+} = OperationTimeout{}
+
+func (o OperationTimeout) ExtensionName() string {
+	return "OperationTimeout"
+}
+
+func (o OperationTimeout) Validate(schema graphql.ExecutableSchema) error {
+	if o.Default < 0 {
+		return errors.New("OperationTimeout default can not be negative")
+	}
+	return nil
+}
+
+func (o OperationTimeout) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	_, err := o.timeout(opCtx)
+	return err
+}
+
+func (o OperationTimeout) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+	if opCtx.Operation.Operation == ast.Subscription {
+		return next(ctx)
+	}
+
+	timeout, err := o.timeout(opCtx)
+	if err != nil || timeout == 0 {
+		return next(ctx)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	responses := next(ctx)
+	return func(respCtx context.Context) *graphql.Response {
+		resp := responses(respCtx)
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) && resp != nil {
+			err := gqlerror.Errorf("operation exceeded its maximum duration of %s", timeout)
+			errcode.Set(err, errOperationTimeout)
+			resp.Errors = append(resp.Errors, err)
+		}
+		if resp == nil || resp.HasNext == nil || !*resp.HasNext {
+			cancel()
+		}
+		return resp
+	}
+}
+
+// timeout returns the limit of the operation, taken from its maxDuration directive if it has one.
+func (o OperationTimeout) timeout(opCtx *graphql.OperationContext) (time.Duration, *gqlerror.Error) {
+	directive := opCtx.Operation.Directives.ForName("maxDuration")
+	if directive == nil {
+		return o.Default, nil
+	}
+
+	arg := directive.Arguments.ForName("ms")
+	if arg == nil {
+		return 0, gqlerror.ErrorPosf(directive.Position, "maxDuration requires the ms argument")
+	}
+	value, err := arg.Value.Value(opCtx.Variables)
+	if err != nil {
+		return 0, gqlerror.ErrorPosf(arg.Position, "maxDuration: %s", err.Error())
+	}
+	ms, err := graphql.UnmarshalInt(value)
+	if err != nil || ms <= 0 {
+		return 0, gqlerror.ErrorPosf(arg.Position, "maxDuration ms must be a positive number of milliseconds")
+	}
+	return time.Duration(ms) * time.Millisecond, nil
+}
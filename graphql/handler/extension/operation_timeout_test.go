@@ -0,0 +1,103 @@
+package extension_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/graphqltest"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestOperationTimeout(t *testing.T) {
+	es := graphqltest.NewMockSchema(`
+		directive @maxDuration(ms: Int!) on QUERY | MUTATION
+		type Query { name: String }
+		type Mutation { name: String }
+	`, func(ctx context.Context) graphql.ResponseHandler {
+		ran := false
+		return func(ctx context.Context) *graphql.Response {
+			if ran {
+				return nil
+			}
+			ran = true
+
+			// mimic a slow resolver that respects the context
+			if _, ok := ctx.Deadline(); !ok {
+				return &graphql.Response{Data: []byte(`{"name":"no deadline"}`)}
+			}
+			select {
+			case <-ctx.Done():
+				return &graphql.Response{Data: []byte(`{"name":null}`)}
+			case <-time.After(5 * time.Second):
+				return &graphql.Response{Data: []byte(`{"name":"finished"}`)}
+			}
+		}
+	})
+
+	newHandler := func(ext extension.OperationTimeout) *handler.Server {
+		h := handler.New(es)
+		h.Use(ext)
+		h.AddTransport(&transport.POST{})
+		return h
+	}
+
+	const timedOut = `{"errors":[{"message":"operation exceeded its maximum duration of 20ms","extensions":{"code":"OPERATION_TIMEOUT"}}],"data":{"name":null}}`
+
+	t.Run("directive sets the deadline", func(t *testing.T) {
+		h := newHandler(extension.OperationTimeout{})
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"query Foo @maxDuration(ms: 20) { name }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, timedOut, resp.Body.String())
+	})
+
+	t.Run("directive applies to its operation only", func(t *testing.T) {
+		h := newHandler(extension.OperationTimeout{})
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"query Foo @maxDuration(ms: 20) { name }"}`)
+		require.JSONEq(t, timedOut, resp.Body.String())
+
+		resp = doRequest(h, "POST", "/graphql", `{"query":"query Bar { name }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"data":{"name":"no deadline"}}`, resp.Body.String())
+	})
+
+	t.Run("directive overrides the default", func(t *testing.T) {
+		h := newHandler(extension.OperationTimeout{Default: time.Hour})
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"mutation Foo @maxDuration(ms: 20) { name }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, timedOut, resp.Body.String())
+	})
+
+	t.Run("default applies without the directive", func(t *testing.T) {
+		h := newHandler(extension.OperationTimeout{Default: 20 * time.Millisecond})
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, timedOut, resp.Body.String())
+	})
+
+	t.Run("directive with a variable", func(t *testing.T) {
+		h := newHandler(extension.OperationTimeout{})
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"query Foo($ms: Int!) @maxDuration(ms: $ms) { name }","variables":{"ms":20}}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, timedOut, resp.Body.String())
+	})
+
+	t.Run("invalid duration", func(t *testing.T) {
+		h := newHandler(extension.OperationTimeout{})
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"query Foo @maxDuration(ms: 0) { name }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"errors":[{"message":"maxDuration ms must be a positive number of milliseconds","locations":[{"line":1,"column":24}]}],"data":null}`, resp.Body.String())
+	})
+}
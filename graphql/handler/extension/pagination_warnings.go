@@ -0,0 +1,117 @@
+package extension
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+const paginationWarningsExtension = "PaginationWarnings"
+
+// PaginationWarnings looks for list fields selected without a pagination limit, and lists them in the
+// "warnings" response extension to guide clients. The operation is executed as usual.
+//
+// Only list fields accepting one of the limit arguments are checked, as clients have no way to bound
+// the others.
+type PaginationWarnings struct {
+	// LimitArguments are the names of the arguments limiting the size of a list, defaults to first and last.
+	LimitArguments []string
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.ResponseInterceptor
+	graphql.HandlerExtension
+} = PaginationWarnings{}
+
+func (p PaginationWarnings) ExtensionName() string {
+	return paginationWarningsExtension
+}
+
+func (p PaginationWarnings) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (p PaginationWarnings) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	limits := p.LimitArguments
+	if len(limits) == 0 {
+		limits = []string{"first", "last"}
+	}
+
+	c := &paginationCollector{limits: limits}
+	c.selectionSet(opCtx.Operation.SelectionSet, nil)
+	if len(c.found) != 0 {
+		opCtx.Stats.SetExtension(paginationWarningsExtension, c.found)
+	}
+	return nil
+}
+
+func (p PaginationWarnings) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if warnings := GetPaginationWarnings(ctx); len(warnings) != 0 {
+		registerWarnings(ctx, warnings)
+	}
+	return next(ctx)
+}
+
+// GetPaginationWarnings returns the unbounded list selections found in the current operation by
+// PaginationWarnings.
+func GetPaginationWarnings(ctx context.Context) []Warning {
+	if !graphql.HasOperationContext(ctx) {
+		return nil
+	}
+
+	s, _ := graphql.GetOperationContext(ctx).Stats.GetExtension(paginationWarningsExtension).([]Warning)
+	return s
+}
+
+type paginationCollector struct {
+	limits []string
+	found  []Warning
+}
+
+func (c *paginationCollector) selectionSet(set ast.SelectionSet, path ast.Path) {
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			fieldPath := append(slices.Clip(path), ast.PathName(sel.Alias))
+			if c.unbounded(sel) {
+				c.found = append(c.found, Warning{
+					Message: fmt.Sprintf("%s.%s selects a list without a %s argument, consider limiting it",
+						sel.ObjectDefinition.Name, sel.Name, strings.Join(c.limits, " or ")),
+					Path: fieldPath,
+				})
+			}
+			c.selectionSet(sel.SelectionSet, fieldPath)
+		case *ast.InlineFragment:
+			c.selectionSet(sel.SelectionSet, path)
+		case *ast.FragmentSpread:
+			if sel.Definition != nil {
+				c.selectionSet(sel.Definition.SelectionSet, path)
+			}
+		}
+	}
+}
+
+func (c *paginationCollector) unbounded(field *ast.Field) bool {
+	if field.Definition == nil || field.ObjectDefinition == nil || field.Definition.Type.Elem == nil {
+		return false
+	}
+
+	accepted := false
+	for _, name := range c.limits {
+		if field.Definition.Arguments.ForName(name) == nil {
+			continue
+		}
+		accepted = true
+		if arg := field.Arguments.ForName(name); arg != nil && arg.Value.Kind != ast.NullValue {
+			return false
+		}
+	}
+	return accepted
+}
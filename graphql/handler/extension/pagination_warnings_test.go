@@ -0,0 +1,60 @@
+package extension_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestPaginationWarnings(t *testing.T) {
+	h := testserver.New()
+	h.Use(extension.PaginationWarnings{})
+	h.AddTransport(&transport.POST{})
+
+	var warnings []extension.Warning
+	h.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+		warnings = extension.GetPaginationWarnings(ctx)
+		return next(ctx)
+	})
+
+	t.Run("bounded list", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ names(first: 10) tags }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+		require.Empty(t, warnings)
+	})
+
+	t.Run("unbounded list", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ bounded: names(last: 5) all: names }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"data":{"name":"test"},"extensions":{"warnings":[{"message":"Query.names selects a list without a first or last argument, consider limiting it","path":["all"]}]}}`, resp.Body.String())
+		require.Equal(t, []extension.Warning{{
+			Message: "Query.names selects a list without a first or last argument, consider limiting it",
+			Path:    ast.Path{ast.PathName("all")},
+		}}, warnings)
+	})
+
+	t.Run("null limit inside a fragment", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ ...F } fragment F on Query { names(first: null) }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"data":{"name":"test"},"extensions":{"warnings":[{"message":"Query.names selects a list without a first or last argument, consider limiting it","path":["names"]}]}}`, resp.Body.String())
+	})
+
+	t.Run("custom limit arguments", func(t *testing.T) {
+		h := testserver.New()
+		h.Use(extension.PaginationWarnings{LimitArguments: []string{"first"}})
+		h.AddTransport(&transport.POST{})
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ names(last: 5) }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"data":{"name":"test"},"extensions":{"warnings":[{"message":"Query.names selects a list without a first argument, consider limiting it","path":["names"]}]}}`, resp.Body.String())
+	})
+}
@@ -0,0 +1,71 @@
+package extension
+
+import (
+	"context"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+const errMixedIntrospection = "INTROSPECTION_MIXED_WITH_DATA"
+
+// PureIntrospection rejects operations selecting __schema or __type alongside regular data fields,
+// so introspection queries can't be used to smuggle data out in the same request. __typename
+// is allowed in both kinds of operation. It doesn't enable introspection, use it along with
+// the Introspection extension.
+type PureIntrospection struct{}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = PureIntrospection{}
+
+func (p PureIntrospection) ExtensionName() string {
+	return "PureIntrospection"
+}
+
+func (p PureIntrospection) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (p PureIntrospection) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	introspection, data := selectedFields(opCtx.Operation.SelectionSet)
+	if !introspection || !data {
+		return nil
+	}
+
+	err := gqlerror.Errorf("introspection fields can't be selected along with data fields")
+	errcode.Set(err, errMixedIntrospection)
+	return err
+}
+
+// selectedFields reports whether set selects introspection fields, __schema or __type, and data
+// fields, walking into fragments. __typename counts as neither.
+func selectedFields(set ast.SelectionSet) (introspection, data bool) {
+	var walk func(set ast.SelectionSet)
+	walk = func(set ast.SelectionSet) {
+		for _, sel := range set {
+			switch sel := sel.(type) {
+			case *ast.Field:
+				switch sel.Name {
+				case "__typename":
+				case "__schema", "__type":
+					introspection = true
+				default:
+					data = true
+				}
+			case *ast.InlineFragment:
+				walk(sel.SelectionSet)
+			case *ast.FragmentSpread:
+				if sel.Definition != nil {
+					walk(sel.Definition.SelectionSet)
+				}
+			}
+		}
+	}
+	walk(set)
+	return introspection, data
+}
@@ -0,0 +1,43 @@
+package extension_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestPureIntrospection(t *testing.T) {
+	h := testserver.New()
+	h.Use(extension.Introspection{})
+	h.Use(extension.PureIntrospection{})
+	h.AddTransport(&transport.POST{})
+
+	t.Run("data only", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ name __typename }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.NotContains(t, resp.Body.String(), "errors")
+	})
+
+	t.Run("introspection only", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ __typename __schema { queryType { name } } __type(name: \"Query\") { name } }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.NotContains(t, resp.Body.String(), "errors")
+	})
+
+	t.Run("introspection mixed with data", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ name __schema { queryType { name } } }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"errors":[{"message":"introspection fields can't be selected along with data fields","extensions":{"code":"INTROSPECTION_MIXED_WITH_DATA"}}],"data":null}`, resp.Body.String())
+	})
+
+	t.Run("introspection mixed with data through fragments", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"query { ... on Query { __type(name: \"Query\") { name } } ...Data } fragment Data on Query { name }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"errors":[{"message":"introspection fields can't be selected along with data fields","extensions":{"code":"INTROSPECTION_MIXED_WITH_DATA"}}],"data":null}`, resp.Body.String())
+	})
+}
@@ -0,0 +1,65 @@
+package extension
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// DefaultRequestIDHeader is the header RequestID reads incoming request ids from.
+const DefaultRequestIDHeader = "X-Request-Id"
+
+// RequestID assigns a request id to every operation, regardless of the transport it arrived on, and
+// stores it in OperationContext.RequestID. The id is taken from the incoming request header when
+// present, otherwise a new one is generated. It is echoed back in the "requestId" response extension.
+type RequestID struct {
+	// Header to read the incoming request id from, defaults to DefaultRequestIDHeader.
+	Header string
+	// Generate returns a new request id when the request did not carry one, defaults to a random UUID.
+	Generate func() string
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.ResponseInterceptor
+	graphql.HandlerExtension
+} = RequestID{}
+
+func (r RequestID) ExtensionName() string {
+	return "RequestID"
+}
+
+func (r RequestID) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (r RequestID) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	header := r.Header
+	if header == "" {
+		header = DefaultRequestIDHeader
+	}
+
+	id := opCtx.Headers.Get(header)
+	if id == "" {
+		if r.Generate != nil {
+			id = r.Generate()
+		} else {
+			id = uuid.NewString()
+		}
+	}
+	opCtx.RequestID = id
+
+	return nil
+}
+
+func (r RequestID) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if graphql.HasOperationContext(ctx) {
+		if id := graphql.GetOperationContext(ctx).RequestID; id != "" {
+			graphql.RegisterExtension(ctx, "requestId", id)
+		}
+	}
+	return next(ctx)
+}
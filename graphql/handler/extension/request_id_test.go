@@ -0,0 +1,88 @@
+package extension_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestRequestID(t *testing.T) {
+	h := testserver.New()
+	h.Use(extension.RequestID{
+		Generate: func() string { return "generated-id" },
+	})
+	h.AddTransport(&transport.POST{})
+	h.AddTransport(transport.Websocket{})
+
+	var requestID string
+	h.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		requestID = graphql.GetOperationContext(ctx).RequestID
+		return next(ctx)
+	})
+
+	t.Run("uses the incoming header over POST", func(t *testing.T) {
+		r := httptest.NewRequest("POST", "/graphql", strings.NewReader(`{"query":"{ name }"}`))
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set("X-Request-Id", "incoming-id")
+		resp := httptest.NewRecorder()
+		h.ServeHTTP(resp, r)
+
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"data":{"name":"test"},"extensions":{"requestId":"incoming-id"}}`, resp.Body.String())
+		require.Equal(t, "incoming-id", requestID)
+	})
+
+	t.Run("generates an id over POST", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"data":{"name":"test"},"extensions":{"requestId":"generated-id"}}`, resp.Body.String())
+		require.Equal(t, "generated-id", requestID)
+	})
+
+	t.Run("uses the upgrade request header over websocket", func(t *testing.T) {
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		header := http.Header{}
+		header.Set("X-Request-Id", "websocket-id")
+		c, resp, err := websocket.DefaultDialer.Dial(strings.ReplaceAll(srv.URL, "http://", "ws://"), header)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		defer c.Close()
+
+		type message struct {
+			Type    string         `json:"type"`
+			ID      string         `json:"id,omitempty"`
+			Payload map[string]any `json:"payload,omitempty"`
+		}
+
+		require.NoError(t, c.WriteJSON(message{Type: "connection_init"}))
+		var msg message
+		require.NoError(t, c.ReadJSON(&msg))
+		require.Equal(t, "connection_ack", msg.Type)
+		require.NoError(t, c.ReadJSON(&msg))
+		require.Equal(t, "ka", msg.Type)
+
+		require.NoError(t, c.WriteJSON(message{
+			Type:    "start",
+			ID:      "test_1",
+			Payload: map[string]any{"query": "subscription { name }"},
+		}))
+		h.SendNextSubscriptionMessage()
+
+		require.NoError(t, c.ReadJSON(&msg))
+		require.Equal(t, "data", msg.Type)
+		require.Equal(t, map[string]any{"requestId": "websocket-id"}, msg.Payload["extensions"])
+		require.Equal(t, "websocket-id", requestID)
+	})
+}
@@ -0,0 +1,121 @@
+package extension
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// ResponseCache caches the responses of queries for TTL, and serves later identical queries from the
+// cache without executing them. Mutations and subscriptions are never cached, neither are responses
+// with errors.
+//
+// Responses are cached by the key returned by KeyFunc. ResponseCacheKey only identifies the
+// operation, so when responses depend on who is asking KeyFunc must add the caller's auth scope to
+// it, eg:
+//
+//	KeyFunc: func(ctx context.Context) string {
+//		return auth.ScopeFromContext(ctx) + ":" + extension.ResponseCacheKey(ctx)
+//	}
+//
+// ResponseCacheKey can be used as is only when every caller may see the responses of the others.
+type ResponseCache struct {
+	Cache graphql.Cache[*CachedResponse]
+	// TTL is how long a response is served from the cache, zero keeps it until it is evicted.
+	TTL time.Duration
+	// KeyFunc returns the cache key of the current operation, an empty key skips the cache. It is
+	// required, so that sharing responses between callers is always a deliberate choice.
+	KeyFunc func(ctx context.Context) string
+}
+
+// CachedResponse is a response stored by ResponseCache.
+type CachedResponse struct {
+	Data    json.RawMessage
+	Expires time.Time
+}
+
+var _ interface {
+	graphql.ResponseInterceptor
+	graphql.HandlerExtension
+} = ResponseCache{}
+
+func (c ResponseCache) ExtensionName() string {
+	return "ResponseCache"
+}
+
+func (c ResponseCache) Validate(schema graphql.ExecutableSchema) error {
+	if c.Cache == nil {
+		return errors.New("ResponseCache.Cache can not be nil")
+	}
+	if c.KeyFunc == nil {
+		return errors.New("ResponseCache.KeyFunc can not be nil")
+	}
+	return nil
+}
+
+func (c ResponseCache) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+
+	opCtx := graphql.GetOperationContext(ctx)
+	if opCtx.Operation == nil || opCtx.Operation.Operation != ast.Query {
+		return next(ctx)
+	}
+
+	key := c.KeyFunc(ctx)
+	if key == "" {
+		return next(ctx)
+	}
+
+	if cached, ok := c.Cache.Get(ctx, key); ok && (cached.Expires.IsZero() || time.Now().Before(cached.Expires)) {
+		return &graphql.Response{
+			Data:       cached.Data,
+			Extensions: graphql.GetExtensions(ctx),
+		}
+	}
+
+	resp := next(ctx)
+	if resp == nil || len(resp.Errors) != 0 || resp.HasNext != nil {
+		return resp
+	}
+
+	cached := &CachedResponse{Data: resp.Data}
+	if c.TTL > 0 {
+		cached.Expires = time.Now().Add(c.TTL)
+	}
+	c.Cache.Add(ctx, key, cached)
+
+	return resp
+}
+
+// ResponseCacheKey returns a key identifying the current operation by its normalized query, operation
+// name and variables. It doesn't identify the caller.
+func ResponseCacheKey(ctx context.Context) string {
+	opCtx := graphql.GetOperationContext(ctx)
+
+	variables, err := json.Marshal(opCtx.Variables)
+	if err != nil {
+		return ""
+	}
+
+	var buf bytes.Buffer
+	formatter.NewFormatter(&buf).FormatQueryDocument(opCtx.Doc)
+
+	hash := sha256.New()
+	hash.Write(buf.Bytes())
+	hash.Write([]byte{0})
+	hash.Write([]byte(opCtx.OperationName))
+	hash.Write([]byte{0})
+	hash.Write(variables)
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,140 @@
+package extension_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestResponseCache(t *testing.T) {
+	newServer := func(rc extension.ResponseCache) (*testserver.TestServer, *int) {
+		h := testserver.New()
+		h.Use(rc)
+		h.AddTransport(&transport.POST{})
+
+		resolved := 0
+		h.AroundFields(func(ctx context.Context, next graphql.Resolver) (res any, err error) {
+			resolved++
+			return next(ctx)
+		})
+		return h, &resolved
+	}
+
+	t.Run("key func is required", func(t *testing.T) {
+		require.PanicsWithError(t, "ResponseCache.KeyFunc can not be nil", func() {
+			newServer(extension.ResponseCache{Cache: graphql.MapCache[*extension.CachedResponse]{}})
+		})
+	})
+
+	t.Run("cache hit skips execution", func(t *testing.T) {
+		h, resolved := newServer(extension.ResponseCache{Cache: graphql.MapCache[*extension.CachedResponse]{}, TTL: time.Minute, KeyFunc: extension.ResponseCacheKey})
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+		require.Equal(t, 1, *resolved)
+
+		resp = doRequest(h, "POST", "/graphql", `{"query":"query {\n  name\n}"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+		require.Equal(t, 1, *resolved)
+	})
+
+	t.Run("different variables miss", func(t *testing.T) {
+		h, resolved := newServer(extension.ResponseCache{Cache: graphql.MapCache[*extension.CachedResponse]{}, KeyFunc: extension.ResponseCacheKey})
+
+		query := `query($id: Int!) { find(id: $id) }`
+		doRequest(h, "POST", "/graphql", `{"query":"`+query+`","variables":{"id":1}}`)
+		doRequest(h, "POST", "/graphql", `{"query":"`+query+`","variables":{"id":1}}`)
+		require.Equal(t, 1, *resolved)
+
+		doRequest(h, "POST", "/graphql", `{"query":"`+query+`","variables":{"id":2}}`)
+		require.Equal(t, 2, *resolved)
+	})
+
+	t.Run("key func scopes the cache", func(t *testing.T) {
+		h, resolved := newServer(extension.ResponseCache{
+			Cache: graphql.MapCache[*extension.CachedResponse]{},
+			KeyFunc: func(ctx context.Context) string {
+				scope := graphql.GetOperationContext(ctx).Headers.Get("Authorization")
+				if scope == "" {
+					return ""
+				}
+				return scope + ":" + extension.ResponseCacheKey(ctx)
+			},
+		})
+
+		// resolves name to the caller, so that responses differ between them
+		h.AroundFields(func(ctx context.Context, next graphql.Resolver) (res any, err error) {
+			if _, err := next(ctx); err != nil {
+				return nil, err
+			}
+			name, _ := json.Marshal(graphql.GetOperationContext(ctx).Headers.Get("Authorization"))
+			return &graphql.Response{Data: []byte(`{"name":` + string(name) + `}`)}, nil
+		})
+
+		request := func(auth string) string {
+			r := httptest.NewRequest("POST", "/graphql", strings.NewReader(`{"query":"{ name }"}`))
+			r.Header.Set("Content-Type", "application/json")
+			if auth != "" {
+				r.Header.Set("Authorization", auth)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+			return w.Body.String()
+		}
+
+		require.JSONEq(t, `{"data":{"name":"alice"}}`, request("alice"))
+		require.JSONEq(t, `{"data":{"name":"alice"}}`, request("alice"))
+		require.Equal(t, 1, *resolved)
+
+		// bob doesn't get the response cached for alice
+		require.JSONEq(t, `{"data":{"name":"bob"}}`, request("bob"))
+		require.JSONEq(t, `{"data":{"name":"bob"}}`, request("bob"))
+		require.Equal(t, 2, *resolved)
+
+		request("")
+		request("")
+		require.Equal(t, 4, *resolved)
+	})
+
+	t.Run("expired responses miss", func(t *testing.T) {
+		h, resolved := newServer(extension.ResponseCache{Cache: graphql.MapCache[*extension.CachedResponse]{}, TTL: time.Nanosecond, KeyFunc: extension.ResponseCacheKey})
+
+		doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`)
+		time.Sleep(time.Millisecond)
+		doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`)
+		require.Equal(t, 2, *resolved)
+	})
+
+	t.Run("mutations and subscriptions bypass the cache", func(t *testing.T) {
+		cache := graphql.MapCache[*extension.CachedResponse]{}
+		h, _ := newServer(extension.ResponseCache{Cache: cache, KeyFunc: extension.ResponseCacheKey})
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"mutation { name }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+		done := make(chan *httptest.ResponseRecorder)
+		go func() {
+			done <- doRequest(h, "POST", "/graphql", `{"query":"subscription { name }"}`)
+		}()
+		h.SendNextSubscriptionMessage()
+		resp = <-done
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+
+		require.Empty(t, cache)
+	})
+}
@@ -0,0 +1,142 @@
+package extension
+
+import (
+	"context"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// ResponseDepthLimit stops resolving fields nested deeper than Limit, so that recursive data such as
+// self-referential graphs can not produce arbitrarily deep responses. Fields past the limit resolve to
+// null with an error on their path, instead of being resolved and serialized.
+//
+// The values of scalars such as Map and Any are checked as well, each nested map, list or struct
+// counting as a level, so that cyclic or deeply nested values resolve to null with an error instead of
+// failing the whole response when they are serialized.
+//
+// The depth of a root field is 1, list indices do not count towards the depth.
+type ResponseDepthLimit struct {
+	Limit int
+}
+
+var _ interface {
+	graphql.FieldInterceptor
+	graphql.HandlerExtension
+} = ResponseDepthLimit{}
+
+func (d ResponseDepthLimit) ExtensionName() string {
+	return "ResponseDepthLimit"
+}
+
+func (d ResponseDepthLimit) Validate(schema graphql.ExecutableSchema) error {
+	if d.Limit < 1 {
+		return errors.New("ResponseDepthLimit limit must be at least 1")
+	}
+	return nil
+}
+
+func (d ResponseDepthLimit) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	fc := graphql.GetFieldContext(ctx)
+	depth := fieldDepth(fc)
+	if depth > d.Limit {
+		return nil, d.errDepth()
+	}
+
+	res, err := next(ctx)
+	if err != nil || len(fc.Field.Selections) != 0 {
+		return res, err
+	}
+	// the field is a scalar or an enum, whose value is serialized as is
+	if valueExceedsDepth(reflect.ValueOf(res), d.Limit-depth) {
+		return nil, d.errDepth()
+	}
+	return res, nil
+}
+
+func (d ResponseDepthLimit) errDepth() error {
+	return fmt.Errorf("response exceeds the maximum depth of %d", d.Limit)
+}
+
+func fieldDepth(fc *graphql.FieldContext) int {
+	depth := 0
+	for ; fc != nil; fc = fc.Parent {
+		if fc.Index == nil && fc.Field.Field != nil {
+			depth++
+		}
+	}
+	return depth
+}
+
+var marshalerTypes = []reflect.Type{
+	reflect.TypeFor[json.Marshaler](),
+	reflect.TypeFor[encoding.TextMarshaler](),
+	reflect.TypeFor[graphql.Marshaler](),
+	reflect.TypeFor[graphql.ContextMarshaler](),
+}
+
+// valueExceedsDepth reports whether v nests maps, lists or structs more than limit levels deep.
+// Values marshaling themselves, such as time.Time, are not looked into.
+func valueExceedsDepth(v reflect.Value, limit int) bool {
+	for {
+		if !v.IsValid() || marshalsItself(v.Type()) {
+			return false
+		}
+		if v.Kind() != reflect.Pointer && v.Kind() != reflect.Interface {
+			break
+		}
+		if v.IsNil() {
+			return false
+		}
+		// checked before dereferencing, as the methods may have a pointer receiver
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Map:
+		if limit == 0 {
+			return true
+		}
+		for iter := v.MapRange(); iter.Next(); {
+			if valueExceedsDepth(iter.Value(), limit-1) {
+				return true
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			// byte slices are serialized as strings
+			return false
+		}
+		if limit == 0 {
+			return true
+		}
+		for i := range v.Len() {
+			if valueExceedsDepth(v.Index(i), limit-1) {
+				return true
+			}
+		}
+	case reflect.Struct:
+		if limit == 0 {
+			return true
+		}
+		for i := range v.NumField() {
+			if v.Type().Field(i).IsExported() && valueExceedsDepth(v.Field(i), limit-1) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func marshalsItself(t reflect.Type) bool {
+	for _, m := range marshalerTypes {
+		if t.Implements(m) {
+			return true
+		}
+	}
+	return false
+}
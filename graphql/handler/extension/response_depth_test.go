@@ -0,0 +1,58 @@
+package extension
+
+import (
+	"context"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type nested struct {
+	Inner *nested
+}
+
+type jsonPointerMarshaler struct{ nested }
+
+func (*jsonPointerMarshaler) MarshalJSON() ([]byte, error) { return []byte(`{}`), nil }
+
+type textPointerMarshaler struct{ nested }
+
+func (*textPointerMarshaler) MarshalText() ([]byte, error) { return nil, nil }
+
+type gqlMarshaler struct{ nested }
+
+func (gqlMarshaler) MarshalGQL(w io.Writer) {}
+
+type gqlContextMarshaler struct{ nested }
+
+func (*gqlContextMarshaler) MarshalGQLContext(ctx context.Context, w io.Writer) error { return nil }
+
+func TestValueExceedsDepth(t *testing.T) {
+	deep := nested{Inner: &nested{Inner: &nested{}}}
+
+	for name, value := range map[string]any{
+		"nil":                          nil,
+		"time":                         time.Now(),
+		"json marshaler with pointer":  &jsonPointerMarshaler{deep},
+		"text marshaler with pointer":  &textPointerMarshaler{deep},
+		"graphql marshaler":            gqlMarshaler{deep},
+		"graphql marshaler pointer":    &gqlMarshaler{deep},
+		"graphql context marshaler":    &gqlContextMarshaler{deep},
+		"marshalers in a map":          map[string]any{"a": &jsonPointerMarshaler{deep}},
+		"byte slice":                   []byte("abc"),
+		"nil pointer to a nested type": (*nested)(nil),
+	} {
+		t.Run(name+" is not looked into", func(t *testing.T) {
+			require.False(t, valueExceedsDepth(reflect.ValueOf(value), 1))
+		})
+	}
+
+	t.Run("nested values count against the limit", func(t *testing.T) {
+		require.True(t, valueExceedsDepth(reflect.ValueOf(&deep), 2))
+		require.False(t, valueExceedsDepth(reflect.ValueOf(&deep), 3))
+		require.True(t, valueExceedsDepth(reflect.ValueOf(map[string]any{"a": []any{1}}), 1))
+	})
+}
@@ -0,0 +1,41 @@
+package extension
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/vektah/gqlparser/v2/formatter"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// SchemaHash adds the sha256 hash of the normalized SDL of the schema to every response as the
+// schemaHash extension, so clients can invalidate the results they cached when the schema changes.
+// The hash is computed once, when the extension is added to the server.
+type SchemaHash struct {
+	hash string
+}
+
+var _ interface {
+	graphql.ResponseInterceptor
+	graphql.HandlerExtension
+} = &SchemaHash{}
+
+func (s SchemaHash) ExtensionName() string {
+	return "SchemaHash"
+}
+
+func (s *SchemaHash) Validate(schema graphql.ExecutableSchema) error {
+	var buf bytes.Buffer
+	formatter.NewFormatter(&buf).FormatSchema(schema.Schema())
+	b := sha256.Sum256(buf.Bytes())
+	s.hash = hex.EncodeToString(b[:])
+	return nil
+}
+
+func (s *SchemaHash) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	graphql.RegisterExtension(ctx, "schemaHash", s.hash)
+	return next(ctx)
+}
@@ -0,0 +1,55 @@
+package extension_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/graphqltest"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestSchemaHash(t *testing.T) {
+	newServer := func(sdl string) *handler.Server {
+		h := handler.New(graphqltest.NewMockSchema(sdl, func(ctx context.Context) graphql.ResponseHandler {
+			return graphql.OneShot(&graphql.Response{Data: []byte(`{"name":"test"}`)})
+		}))
+		h.AddTransport(&transport.POST{})
+		h.Use(&extension.SchemaHash{})
+		return h
+	}
+
+	schemaHash := func(h http.Handler) string {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+		var body struct {
+			Data       json.RawMessage
+			Extensions struct {
+				SchemaHash string `json:"schemaHash"`
+			}
+		}
+		require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &body))
+		require.JSONEq(t, `{"name":"test"}`, string(body.Data))
+		return body.Extensions.SchemaHash
+	}
+
+	v1 := newServer(`type Query { name: String! }`)
+	hash := schemaHash(v1)
+	require.Len(t, hash, 64)
+	require.Equal(t, hash, schemaHash(v1))
+
+	t.Run("formatting doesn't change the hash", func(t *testing.T) {
+		require.Equal(t, hash, schemaHash(newServer("type Query {\n\n  name:   String!\n}\n")))
+	})
+
+	t.Run("changing the schema changes the hash", func(t *testing.T) {
+		require.NotEqual(t, hash, schemaHash(newServer(`type Query { name: String! age: Int }`)))
+	})
+}
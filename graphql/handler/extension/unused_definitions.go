@@ -0,0 +1,94 @@
+package extension
+
+import (
+	"context"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/validator/rules"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+const unusedDefinitionsExtension = "UnusedDefinitions"
+
+// UnusedDefinitions takes over the validation of the fragments and variables a document declares
+// without using them. They fail the operation as they do without this extension, unless Warn is
+// set, in which case they are listed in the "warnings" response extension and the operation is
+// executed as usual.
+type UnusedDefinitions struct {
+	// Warn reports unused definitions as warnings instead of errors.
+	Warn bool
+}
+
+var _ interface {
+	graphql.ValidationRuleOverride
+	graphql.OperationContextMutator
+	graphql.ResponseInterceptor
+	graphql.HandlerExtension
+} = UnusedDefinitions{}
+
+func (u UnusedDefinitions) ExtensionName() string {
+	return unusedDefinitionsExtension
+}
+
+func (u UnusedDefinitions) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (u UnusedDefinitions) OverriddenValidationRules() []string {
+	return []string{rules.NoUnusedFragmentsRule.Name, rules.NoUnusedVariablesRule.Name}
+}
+
+func (u UnusedDefinitions) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	var unused gqlerror.List
+
+	used := map[string]bool{}
+	for _, op := range opCtx.Doc.Operations {
+		for _, def := range usedFragments(opCtx.Doc, op) {
+			used[def.Name] = true
+		}
+		for _, v := range op.VariableDefinitions {
+			// Used is set while the document is validated
+			if v.Used {
+				continue
+			}
+			if op.Name != "" {
+				unused = append(unused, gqlerror.ErrorPosf(v.Position, `Variable "$%s" is never used in operation "%s".`, v.Variable, op.Name))
+			} else {
+				unused = append(unused, gqlerror.ErrorPosf(v.Position, `Variable "$%s" is never used.`, v.Variable))
+			}
+		}
+	}
+	for _, def := range opCtx.Doc.Fragments {
+		if !used[def.Name] {
+			unused = append(unused, gqlerror.ErrorPosf(def.Position, `Fragment "%s" is never used.`, def.Name))
+		}
+	}
+
+	if len(unused) == 0 {
+		return nil
+	}
+
+	if !u.Warn {
+		errcode.Set(unused[0], errcode.ValidationFailed)
+		return unused[0]
+	}
+
+	warnings := make([]Warning, len(unused))
+	for i, err := range unused {
+		warnings[i] = Warning{Message: err.Message}
+	}
+	opCtx.Stats.SetExtension(unusedDefinitionsExtension, warnings)
+	return nil
+}
+
+func (u UnusedDefinitions) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if graphql.HasOperationContext(ctx) {
+		warnings, _ := graphql.GetOperationContext(ctx).Stats.GetExtension(unusedDefinitionsExtension).([]Warning)
+		if len(warnings) != 0 {
+			registerWarnings(ctx, warnings)
+		}
+	}
+	return next(ctx)
+}
@@ -0,0 +1,65 @@
+package extension_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestUnusedDefinitions(t *testing.T) {
+	const (
+		unusedFragment = `{"query":"query { name } fragment Unused on Query { name }"}`
+		unusedVariable = `{"query":"query Named($id: Int) { name }","variables":{"id":1}}`
+	)
+
+	t.Run("errors", func(t *testing.T) {
+		h := testserver.New()
+		h.Use(extension.UnusedDefinitions{})
+		h.AddTransport(&transport.POST{})
+
+		resp := doRequest(h, "POST", "/graphql", unusedFragment)
+		require.Equal(t, http.StatusUnprocessableEntity, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"errors":[{"message":"Fragment \"Unused\" is never used.","locations":[{"line":1,"column":16}],"extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`, resp.Body.String())
+
+		resp = doRequest(h, "POST", "/graphql", unusedVariable)
+		require.Equal(t, http.StatusUnprocessableEntity, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"errors":[{"message":"Variable \"$id\" is never used in operation \"Named\".","locations":[{"line":1,"column":13}],"extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`, resp.Body.String())
+	})
+
+	t.Run("warnings", func(t *testing.T) {
+		h := testserver.New()
+		h.Use(extension.UnusedDefinitions{Warn: true})
+		h.AddTransport(&transport.POST{})
+
+		resp := doRequest(h, "POST", "/graphql", unusedFragment)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"data":{"name":"test"},"extensions":{"warnings":[{"message":"Fragment \"Unused\" is never used."}]}}`, resp.Body.String())
+
+		resp = doRequest(h, "POST", "/graphql", unusedVariable)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"data":{"name":"test"},"extensions":{"warnings":[{"message":"Variable \"$id\" is never used in operation \"Named\"."}]}}`, resp.Body.String())
+
+		resp = doRequest(h, "POST", "/graphql", `{"query":"query { ...Used } fragment Used on Query { name }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+	})
+
+	t.Run("warnings are shared with other extensions", func(t *testing.T) {
+		h := testserver.New()
+		h.Use(extension.UnusedDefinitions{Warn: true})
+		h.Use(extension.PaginationWarnings{})
+		h.AddTransport(&transport.POST{})
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"query($unused: Int) { all: names }"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.JSONEq(t, `{"data":{"name":"test"},"extensions":{"warnings":[
+			{"message":"Variable \"$unused\" is never used."},
+			{"message":"Query.names selects a list without a first or last argument, consider limiting it","path":["all"]}
+		]}}`, resp.Body.String())
+	})
+}
@@ -0,0 +1,25 @@
+package extension
+
+import (
+	"context"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// Warning is a non-fatal issue found in an operation.
+type Warning struct {
+	Message string   `json:"message"`
+	Path    ast.Path `json:"path,omitempty"`
+}
+
+// registerWarnings adds warnings to the "warnings" response extension, which is shared by the
+// extensions reporting non-fatal issues.
+func registerWarnings(ctx context.Context, warnings []Warning) {
+	if registered, ok := graphql.GetExtension(ctx, "warnings").(*[]Warning); ok {
+		*registered = append(*registered, warnings...)
+		return
+	}
+	graphql.RegisterExtension(ctx, "warnings", &warnings)
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+type (
+	// Router serves several executable schemas with the same transports. Each operation is executed by
+	// the Server returned by the RouteFunc, with that server's extensions, caches and error presenter.
+	// The transports added to the servers themselves are not used.
+	Router struct {
+		transports    []graphql.Transport
+		defaultServer *Server
+		route         RouteFunc
+	}
+
+	// RouteFunc picks the server that executes an operation from its raw parameters, before they are
+	// parsed. Returning nil executes the operation with the default server of the router.
+	RouteFunc func(ctx context.Context, params *graphql.RawParams) *Server
+)
+
+// NewRouter creates a router executing operations with the server returned by route. Errors that happen
+// before the raw parameters of an operation are read, such as a body that isn't valid JSON, are sent
+// by defaultServer.
+func NewRouter(defaultServer *Server, route RouteFunc) *Router {
+	return &Router{
+		defaultServer: defaultServer,
+		route:         route,
+	}
+}
+
+func (r *Router) AddTransport(transport graphql.Transport) {
+	r.transports = append(r.transports, transport)
+}
+
+func (r *Router) getTransport(req *http.Request) graphql.Transport {
+	for _, t := range r.transports {
+		if t.Supports(req) {
+			return t
+		}
+	}
+	return nil
+}
+
+func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	defer func() {
+		if err := recover(); err != nil {
+			err := r.defaultServer.exec.PresentRecoveredError(req.Context(), err)
+			gqlErr, _ := err.(*gqlerror.Error)
+			resp := &graphql.Response{Errors: []*gqlerror.Error{gqlErr}}
+			b, _ := json.Marshal(resp)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write(b)
+		}
+	}()
+
+	req = req.WithContext(graphql.StartOperationTrace(req.Context()))
+
+	tr := r.getTransport(req)
+	if tr == nil {
+		sendErrorf(w, http.StatusBadRequest, "transport not supported")
+		return
+	}
+
+	exec := &routedExecutor{router: r}
+	req = req.WithContext(transport.WithBytesWrittenFunc(req.Context(), exec.bytesWritten))
+	tr.Do(w, req, exec)
+}
+
+// routerExtension is the name of the operation stats holding the server an operation was routed to.
+const routerExtension = "Router"
+
+// routedExecutor executes the operations of a request, or websocket connection, with the executor of
+// the server they were routed to.
+type routedExecutor struct {
+	router *Router
+}
+
+var _ graphql.GraphExecutor = &routedExecutor{}
+
+// operationServer returns the server opCtx was routed to when it was created.
+func (e *routedExecutor) operationServer(opCtx *graphql.OperationContext) *Server {
+	if srv, ok := opCtx.Stats.GetExtension(routerExtension).(*Server); ok {
+		return srv
+	}
+	return e.router.defaultServer
+}
+
+func (e *routedExecutor) CreateOperationContext(ctx context.Context, params *graphql.RawParams) (*graphql.OperationContext, gqlerror.List) {
+	srv := e.router.route(ctx, params)
+	if srv == nil {
+		srv = e.router.defaultServer
+	}
+	opCtx, errs := srv.exec.CreateOperationContext(ctx, params)
+	if opCtx != nil {
+		opCtx.Stats.SetExtension(routerExtension, srv)
+	}
+	return opCtx, errs
+}
+
+func (e *routedExecutor) DispatchOperation(ctx context.Context, opCtx *graphql.OperationContext) (graphql.ResponseHandler, context.Context) {
+	return e.operationServer(opCtx).exec.DispatchOperation(ctx, opCtx)
+}
+
+func (e *routedExecutor) DispatchError(ctx context.Context, list gqlerror.List) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) {
+		return e.router.defaultServer.exec.DispatchError(ctx, list)
+	}
+	return e.operationServer(graphql.GetOperationContext(ctx)).exec.DispatchError(ctx, list)
+}
+
+// bytesWritten reports the bytes written for an operation to the BytesWrittenFunc of the server it was
+// routed to, as the transports report them once the operation was dispatched.
+func (e *routedExecutor) bytesWritten(ctx context.Context, n int64) {
+	srv := e.router.defaultServer
+	if graphql.HasOperationContext(ctx) {
+		srv = e.operationServer(graphql.GetOperationContext(ctx))
+	}
+	if srv.bytesWrittenFunc != nil {
+		srv.bytesWrittenFunc(ctx, n)
+	}
+}
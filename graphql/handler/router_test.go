@@ -0,0 +1,120 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestRouter(t *testing.T) {
+	// v1 only knows about name, and fails to resolve it
+	v1 := testserver.NewError()
+	v2 := testserver.New()
+	v2.SetErrorPresenter(func(ctx context.Context, err error) *gqlerror.Error {
+		gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+		gqlErr.Message = "v2: " + gqlErr.Message
+		return gqlErr
+	})
+
+	routed := 0
+	router := handler.NewRouter(v1.Server, func(ctx context.Context, params *graphql.RawParams) *handler.Server {
+		routed++
+		if strings.HasPrefix(params.OperationName, "v2_") {
+			return v2.Server
+		}
+		return nil
+	})
+	router.AddTransport(transport.GET{})
+	router.AddTransport(transport.POST{})
+
+	doPost := func(body string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("POST", "/graphql", strings.NewReader(body))
+		r.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		return w
+	}
+
+	t.Run("v2 operations use the v2 schema", func(t *testing.T) {
+		resp := doPost(`{"query":"query v2_find { find(id: 1) }","operationName":"v2_find"}`)
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+	})
+
+	t.Run("other operations use the default schema", func(t *testing.T) {
+		resp := doPost(`{"query":"query legacy { name }","operationName":"legacy"}`)
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.JSONEq(t, `{"errors":[{"message":"resolver error"}],"data":null}`, resp.Body.String())
+
+		resp = doPost(`{"query":"query legacy { find(id: 1) }","operationName":"legacy"}`)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code, resp.Body.String())
+		assert.Contains(t, resp.Body.String(), `Cannot query field \"find\" on type \"Query\".`)
+	})
+
+	t.Run("errors are presented by the routed server", func(t *testing.T) {
+		resp := doPost(`{"query":"query v2_broken { unknown }","operationName":"v2_broken"}`)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code, resp.Body.String())
+		assert.Contains(t, resp.Body.String(), `"message":"v2: Cannot query field \"unknown\" on type \"Query\"."`)
+	})
+
+	t.Run("errors before routing use the default server", func(t *testing.T) {
+		resp := doPost(`{"query":`)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, resp.Body.String())
+		assert.JSONEq(t, `{"errors":[{"message":"json request body could not be decoded: unexpected EOF body:{\"query\":"}],"data":null}`, resp.Body.String())
+	})
+
+	t.Run("GET requests are routed too", func(t *testing.T) {
+		resp := get(router, "/graphql?operationName=v2_find&query="+url.QueryEscape("query v2_find { find(id: 1) }"))
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+	})
+
+	t.Run("bytes written are reported to the routed server", func(t *testing.T) {
+		var v1Written, v2Written int64
+		v1.SetBytesWrittenFunc(func(ctx context.Context, bytes int64) { v1Written += bytes })
+		v2.SetBytesWrittenFunc(func(ctx context.Context, bytes int64) { v2Written += bytes })
+		defer v1.SetBytesWrittenFunc(nil)
+		defer v2.SetBytesWrittenFunc(nil)
+
+		resp := doPost(`{"query":"query v2_find { find(id: 1) }","operationName":"v2_find"}`)
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.EqualValues(t, resp.Body.Len(), v2Written)
+		assert.Zero(t, v1Written)
+
+		resp = doPost(`{"query":"query legacy { name }","operationName":"legacy"}`)
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.EqualValues(t, resp.Body.Len(), v1Written)
+	})
+
+	t.Run("operations are routed once", func(t *testing.T) {
+		v2.SetBytesWrittenFunc(func(ctx context.Context, bytes int64) {})
+		defer v2.SetBytesWrittenFunc(nil)
+
+		routed = 0
+		resp := doPost(`{"query":"query v2_find { find(id: 1) }","operationName":"v2_find"}`)
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.Equal(t, 1, routed)
+
+		routed = 0
+		resp = doPost(`{"query":"query v2_broken { unknown }","operationName":"v2_broken"}`)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code, resp.Body.String())
+		assert.Equal(t, 1, routed)
+	})
+
+	t.Run("returns an error if no transport matches", func(t *testing.T) {
+		resp := post(router, "/graphql", "text/plain")
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.JSONEq(t, `{"errors":[{"message":"transport not supported"}],"data":null}`, resp.Body.String())
+	})
+}
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"sync"
+
+	"github.com/vektah/gqlparser/v2/formatter"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// SchemaHandler returns a handler that serves the normalized SDL of the executable schema as plain
+// text, for schema registries and other tooling that want the raw schema rather than introspection.
+// Mount it on a dedicated route, eg:
+//
+//	http.Handle("/graphql/schema", handler.SchemaHandler(es))
+func SchemaHandler(es graphql.ExecutableSchema) http.Handler {
+	sdl := sync.OnceValue(func() []byte {
+		var buf bytes.Buffer
+		formatter.NewFormatter(&buf).FormatSchema(es.Schema())
+		return buf.Bytes()
+	})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			sendErrorf(w, http.StatusMethodNotAllowed, "method %s not allowed", r.Method)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(sdl())
+		}
+	})
+}
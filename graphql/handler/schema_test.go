@@ -0,0 +1,44 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+)
+
+func TestSchemaHandler(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		type Query {
+			user(id: ID!): User
+		}
+		type User {
+			id: ID!
+			name: String!
+		}
+	`})
+	h := handler.SchemaHandler(&graphql.ExecutableSchemaMock{
+		SchemaFunc: func() *ast.Schema { return schema },
+	})
+
+	t.Run("serves the SDL", func(t *testing.T) {
+		resp := get(h, "/graphql/schema")
+		require.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "text/plain; charset=utf-8", resp.Header().Get("Content-Type"))
+		assert.Contains(t, resp.Body.String(), "type Query {\n\tuser(id: ID!): User\n}")
+		assert.Contains(t, resp.Body.String(), "type User {\n\tid: ID!\n\tname: String!\n}")
+		assert.NotContains(t, resp.Body.String(), "__Schema")
+	})
+
+	t.Run("rejects other methods", func(t *testing.T) {
+		resp := post(h, "/graphql/schema", "application/json")
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.Code)
+		assert.Equal(t, "GET, HEAD", resp.Header().Get("Allow"))
+	})
+}
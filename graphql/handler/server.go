@@ -20,8 +20,9 @@ import (
 
 type (
 	Server struct {
-		transports []graphql.Transport
-		exec       *executor.Executor
+		transports       []graphql.Transport
+		exec             *executor.Executor
+		bytesWrittenFunc transport.BytesWrittenFunc
 	}
 )
 
@@ -79,6 +80,10 @@ func (s *Server) SetRecoverFunc(f graphql.RecoverFunc) {
 	s.exec.SetRecoverFunc(f)
 }
 
+func (s *Server) SetRequestExtensionsFunc(f graphql.RequestExtensionsFunc) {
+	s.exec.SetRequestExtensionsFunc(f)
+}
+
 func (s *Server) SetQueryCache(cache graphql.Cache[*ast.QueryDocument]) {
 	s.exec.SetQueryCache(cache)
 }
@@ -87,10 +92,38 @@ func (s *Server) SetParserTokenLimit(limit int) {
 	s.exec.SetParserTokenLimit(limit)
 }
 
+func (s *Server) SetMaxQueryLength(limit int) {
+	s.exec.SetMaxQueryLength(limit)
+}
+
+func (s *Server) SetMaxOperationsPerDocument(limit int) {
+	s.exec.SetMaxOperationsPerDocument(limit)
+}
+
 func (s *Server) SetDisableSuggestion(value bool) {
 	s.exec.SetDisableSuggestion(value)
 }
 
+// SetIgnoreUnknownInputFields drops the fields of input object variables that are not defined by the
+// schema instead of rejecting the operation. Rejecting them is the default, as variable validation
+// always did, so strict handling needs no option and this one opts out of it instead, eg. to keep old
+// clients working after an input field is removed.
+func (s *Server) SetIgnoreUnknownInputFields(value bool) {
+	s.exec.SetIgnoreUnknownInputFields(value)
+}
+
+// SetCaseInsensitiveEnums accepts enum values of queries and variables in any case, see
+// executor.Executor.SetCaseInsensitiveEnums.
+func (s *Server) SetCaseInsensitiveEnums(value bool) {
+	s.exec.SetCaseInsensitiveEnums(value)
+}
+
+// SetBytesWrittenFunc reports the number of bytes the transports wrote for each operation once it is
+// done, see transport.BytesWrittenFunc.
+func (s *Server) SetBytesWrittenFunc(f transport.BytesWrittenFunc) {
+	s.bytesWrittenFunc = f
+}
+
 func (s *Server) Use(extension graphql.HandlerExtension) {
 	s.exec.Use(extension)
 }
@@ -110,11 +143,46 @@ func (s *Server) AroundOperations(f graphql.OperationMiddleware) {
 	s.exec.AroundOperations(f)
 }
 
+// AroundOperationsOfType is a convenience method for creating an extension that only implements operation middleware,
+// and is only called for operations of the given type
+func (s *Server) AroundOperationsOfType(operation ast.Operation, f graphql.OperationMiddleware) {
+	s.exec.AroundOperationsOfType(operation, f)
+}
+
+// AroundSubscriptions is a convenience method for creating an extension that only implements operation middleware,
+// and is only called for subscription operations
+func (s *Server) AroundSubscriptions(f graphql.OperationMiddleware) {
+	s.exec.AroundSubscriptions(f)
+}
+
+// WithContextValue is a convenience method for creating an extension that adds the value returned by valueFunc
+// to the context of every operation under key, so that resolvers can read it with ctx.Value(key)
+func (s *Server) WithContextValue(key any, valueFunc func(ctx context.Context) any) {
+	s.exec.WithContextValue(key, valueFunc)
+}
+
 // AroundResponses is a convenience method for creating an extension that only implements response middleware
 func (s *Server) AroundResponses(f graphql.ResponseMiddleware) {
 	s.exec.AroundResponses(f)
 }
 
+// ExecuteDocument executes an operation of doc, a document that was already parsed and validated
+// against the schema, without going through a transport. It's meant for trusted callers within the
+// process, as doc isn't checked at all. Subscriptions only return their first response, and are
+// cancelled once it is returned.
+func (s *Server) ExecuteDocument(ctx context.Context, doc *ast.QueryDocument, params *graphql.RawParams) *graphql.Response {
+	ctx = graphql.StartOperationTrace(ctx)
+	rc, errs := s.exec.CreateOperationContextFromDocument(ctx, doc, params)
+	if errs != nil {
+		return s.exec.DispatchError(graphql.WithOperationContext(ctx, rc), errs)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	responses, ctx := s.exec.DispatchOperation(ctx, rc)
+	return responses(ctx)
+}
+
 func (s *Server) getTransport(r *http.Request) graphql.Transport {
 	for _, t := range s.transports {
 		if t.Supports(r) {
@@ -137,6 +205,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}()
 
 	r = r.WithContext(graphql.StartOperationTrace(r.Context()))
+	if s.bytesWrittenFunc != nil {
+		r = r.WithContext(transport.WithBytesWrittenFunc(r.Context(), s.bytesWrittenFunc))
+	}
 
 	transport := s.getTransport(r)
 	if transport == nil {
@@ -2,11 +2,15 @@ package handler_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -15,6 +19,9 @@ import (
 	"github.com/vektah/gqlparser/v2/parser"
 
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/graphqltest"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/testserver"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 )
@@ -164,6 +171,294 @@ func TestErrorServer(t *testing.T) {
 	})
 }
 
+func TestAroundSubscriptions(t *testing.T) {
+	srv := testserver.New()
+	srv.AddTransport(&transport.GET{})
+	srv.AddTransport(&transport.POST{})
+
+	var calls []string
+	srv.AroundSubscriptions(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		calls = append(calls, "subscription")
+		return next(ctx)
+	})
+	srv.AroundOperationsOfType(ast.Query, func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		calls = append(calls, "query")
+		return next(ctx)
+	})
+
+	t.Run("runs for subscriptions", func(t *testing.T) {
+		calls = nil
+		done := make(chan *httptest.ResponseRecorder)
+		go func() {
+			r := httptest.NewRequest("POST", "/foo", strings.NewReader(`{"query":"subscription { name }"}`))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			srv.ServeHTTP(w, r)
+			done <- w
+		}()
+		srv.SendNextSubscriptionMessage()
+
+		resp := <-done
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+		assert.Equal(t, []string{"subscription"}, calls)
+	})
+
+	t.Run("does not run for queries", func(t *testing.T) {
+		calls = nil
+		resp := get(srv, "/foo?query={name}")
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.Equal(t, []string{"query"}, calls)
+	})
+}
+
+func TestRequestExtensionsFunc(t *testing.T) {
+	const hash = "30166fc3298853f22709fce1e4a00e98f1b6a3160eaaaf9cb3b7db6a16073b07"
+
+	srv := testserver.New()
+	srv.AddTransport(&transport.GET{})
+	srv.Use(extension.AutomaticPersistedQuery{Cache: graphql.MapCache[string]{}})
+
+	var seen map[string]any
+	srv.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		seen = graphql.GetOperationContext(ctx).Extensions
+		return next(ctx)
+	})
+	srv.SetRequestExtensionsFunc(func(ctx context.Context, extensions map[string]any) (map[string]any, error) {
+		if _, ok := extensions["telemetry"].(map[string]any); extensions["telemetry"] != nil && !ok {
+			return nil, errors.New("telemetry extension must be an object")
+		}
+		known := map[string]any{}
+		for _, key := range []string{"persistedQuery", "telemetry"} {
+			if v, ok := extensions[key]; ok {
+				known[key] = v
+			}
+		}
+		return known, nil
+	})
+
+	request := func(query, extensions string) *httptest.ResponseRecorder {
+		params := url.Values{"extensions": {extensions}}
+		if query != "" {
+			params.Set("query", query)
+		}
+		return get(srv, "/foo?"+params.Encode())
+	}
+
+	t.Run("strips unknown keys", func(t *testing.T) {
+		resp := request("{ name }", `{"persistedQuery":{"version":1,"sha256Hash":"`+hash+`"},"telemetry":{"client":"web"},"unknown":true}`)
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+		assert.Contains(t, seen, "persistedQuery")
+		assert.Contains(t, seen, "telemetry")
+		assert.NotContains(t, seen, "unknown")
+	})
+
+	t.Run("apq still works with the known keys", func(t *testing.T) {
+		resp := request("", `{"persistedQuery":{"version":1,"sha256Hash":"`+hash+`"},"unknown":true}`)
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+		assert.NotContains(t, seen, "unknown")
+	})
+
+	t.Run("rejects invalid extensions", func(t *testing.T) {
+		resp := request("{name}", `{"telemetry":"web"}`)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code, resp.Body.String())
+		assert.JSONEq(t, `{"errors":[{"message":"telemetry extension must be an object","extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`, resp.Body.String())
+	})
+}
+
+func TestExecuteDocument(t *testing.T) {
+	srv := handler.New(graphqltest.NewMockSchema(`type Query { greet(name: String!): String! }`, func(ctx context.Context) graphql.ResponseHandler {
+		ran := false
+		return func(ctx context.Context) *graphql.Response {
+			if ran {
+				return nil
+			}
+			ran = true
+			// greet each root field by its alias, as generated code would
+			opCtx := graphql.GetOperationContext(ctx)
+			data := map[string]string{}
+			for _, sel := range opCtx.Operation.SelectionSet {
+				field := sel.(*ast.Field)
+				data[field.Alias] = "hello " + field.ArgumentMap(opCtx.Variables)["name"].(string)
+			}
+			b, _ := json.Marshal(data)
+			return &graphql.Response{Data: b}
+		}
+	}))
+
+	greet := func(alias string) *ast.Field {
+		return &ast.Field{
+			Alias: alias,
+			Name:  "greet",
+			Arguments: ast.ArgumentList{{
+				Name:  "name",
+				Value: &ast.Value{Kind: ast.Variable, Raw: "name"},
+			}},
+			Definition: &ast.FieldDefinition{
+				Name:      "greet",
+				Arguments: ast.ArgumentDefinitionList{{Name: "name", Type: ast.NonNullNamedType("String", nil)}},
+				Type:      ast.NonNullNamedType("String", nil),
+			},
+		}
+	}
+	doc := &ast.QueryDocument{
+		Operations: ast.OperationList{{
+			Operation: ast.Query,
+			VariableDefinitions: ast.VariableDefinitionList{{
+				Variable:   "name",
+				Type:       ast.NonNullNamedType("String", nil),
+				Definition: &ast.Definition{Kind: ast.Scalar, Name: "String"},
+			}},
+			SelectionSet: ast.SelectionSet{greet("first"), greet("second")},
+		}},
+	}
+
+	t.Run("executes a document that was never parsed", func(t *testing.T) {
+		resp := srv.ExecuteDocument(context.Background(), doc, &graphql.RawParams{
+			Variables: map[string]any{"name": "gopher"},
+		})
+		require.Empty(t, resp.Errors)
+		assert.JSONEq(t, `{"first":"hello gopher","second":"hello gopher"}`, string(resp.Data))
+	})
+
+	t.Run("returns operation errors", func(t *testing.T) {
+		resp := srv.ExecuteDocument(context.Background(), doc, &graphql.RawParams{})
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, "must be defined", resp.Errors[0].Message)
+		assert.Equal(t, ast.Path{ast.PathName("variable"), ast.PathName("name")}, resp.Errors[0].Path)
+	})
+
+	t.Run("cancels subscriptions after their first response", func(t *testing.T) {
+		cancelled := make(chan struct{})
+		srv := handler.New(graphqltest.NewMockSchema(`type Query { a: String } type Subscription { tick: String }`, func(ctx context.Context) graphql.ResponseHandler {
+			go func() {
+				<-ctx.Done()
+				close(cancelled)
+			}()
+			return func(ctx context.Context) *graphql.Response {
+				return &graphql.Response{Data: []byte(`{"tick":"1"}`)}
+			}
+		}))
+
+		resp := srv.ExecuteDocument(context.Background(), &ast.QueryDocument{
+			Operations: ast.OperationList{{Operation: ast.Subscription}},
+		}, &graphql.RawParams{})
+		assert.JSONEq(t, `{"tick":"1"}`, string(resp.Data))
+		select {
+		case <-cancelled:
+		case <-time.After(time.Second):
+			t.Fatal("subscription was not cancelled")
+		}
+	})
+}
+
+func TestBytesWrittenFunc(t *testing.T) {
+	srv := testserver.New()
+	srv.AddTransport(transport.SSE{})
+	srv.AddTransport(&transport.POST{})
+
+	var reported int64
+	var operation string
+	srv.SetBytesWrittenFunc(func(ctx context.Context, bytes int64) {
+		reported += bytes
+		operation = graphql.GetOperationContext(ctx).OperationName
+	})
+
+	t.Run("query", func(t *testing.T) {
+		reported = 0
+		r := httptest.NewRequest("POST", "/foo", strings.NewReader(`{"query":"query Named { name }","operationName":"Named"}`))
+		r.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		srv.ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.EqualValues(t, w.Body.Len(), reported)
+		assert.Equal(t, "Named", operation)
+	})
+
+	t.Run("subscription", func(t *testing.T) {
+		reported = 0
+		go func() {
+			srv.SendNextSubscriptionMessage()
+			srv.SendNextSubscriptionMessage()
+			srv.SendCompleteSubscriptionMessage()
+		}()
+
+		r := httptest.NewRequest("POST", "/foo", strings.NewReader(`{"query":"subscription { name }"}`))
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set("Accept", "text/event-stream")
+		w := httptest.NewRecorder()
+		srv.ServeHTTP(w, r)
+
+		body := strings.TrimPrefix(w.Body.String(), ":\n\n")
+		assert.Equal(t, 2, strings.Count(body, "event: next\n"), body)
+		assert.EqualValues(t, len(body), reported)
+	})
+}
+
+type tenantKey struct{}
+
+func TestWithContextValue(t *testing.T) {
+	es := graphqltest.NewMockSchema(`
+		type Query { tenant: String! }
+		type Mutation { tenant: String! }
+		type Subscription { tenant: String! }
+	`, func(ctx context.Context) graphql.ResponseHandler {
+		// the value is read where generated code would call the resolvers
+		tenant, _ := ctx.Value(tenantKey{}).(string)
+		data := []byte(`{"tenant":"` + tenant + `"}`)
+		if graphql.GetOperationContext(ctx).Operation.Operation != ast.Subscription {
+			return graphql.OneShot(&graphql.Response{Data: data})
+		}
+		sent := false
+		return func(ctx context.Context) *graphql.Response {
+			if sent {
+				return nil
+			}
+			sent = true
+			return &graphql.Response{Data: data}
+		}
+	})
+	srv := handler.New(es)
+	srv.AddTransport(transport.SSE{})
+	srv.AddTransport(transport.POST{})
+	srv.WithContextValue(tenantKey{}, func(ctx context.Context) any {
+		return graphql.GetOperationContext(ctx).Headers.Get("X-Tenant")
+	})
+
+	do := func(query, accept string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("POST", "/graphql", strings.NewReader(`{"query":"`+query+`"}`))
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set("X-Tenant", "acme")
+		if accept != "" {
+			r.Header.Set("Accept", accept)
+		}
+		w := httptest.NewRecorder()
+		srv.ServeHTTP(w, r)
+		return w
+	}
+
+	t.Run("query", func(t *testing.T) {
+		resp := do("query { tenant }", "")
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.JSONEq(t, `{"data":{"tenant":"acme"}}`, resp.Body.String())
+	})
+
+	t.Run("mutation", func(t *testing.T) {
+		resp := do("mutation { tenant }", "")
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.JSONEq(t, `{"data":{"tenant":"acme"}}`, resp.Body.String())
+	})
+
+	t.Run("subscription", func(t *testing.T) {
+		resp := do("subscription { tenant }", "text/event-stream")
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.Contains(t, resp.Body.String(), `data: {"data":{"tenant":"acme"}}`)
+	})
+}
+
 type panicTransport struct{}
 
 func (t panicTransport) Supports(r *http.Request) bool {
@@ -174,6 +469,46 @@ func (t panicTransport) Do(w http.ResponseWriter, r *http.Request, exec graphql.
 	panic(errors.New("panic in transport"))
 }
 
+func TestFragmentDepth(t *testing.T) {
+	srv := testserver.New()
+	srv.AddTransport(&transport.POST{})
+
+	// chainedFragments returns a query spreading n fragments, each nested in the previous one
+	chainedFragments := func(n int) string {
+		var query strings.Builder
+		query.WriteString("query { ...Fragment0 }")
+		for i := range n {
+			fmt.Fprintf(&query, " fragment Fragment%d on Query { name", i)
+			if i+1 < n {
+				fmt.Fprintf(&query, " ...Fragment%d", i+1)
+			}
+			query.WriteString(" }")
+		}
+		return query.String()
+	}
+	do := func(query string) *httptest.ResponseRecorder {
+		body, err := json.Marshal(map[string]string{"query": query})
+		require.NoError(t, err)
+		r := httptest.NewRequest("POST", "/foo", strings.NewReader(string(body)))
+		r.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		srv.ServeHTTP(w, r)
+		return w
+	}
+
+	t.Run("rejects fragments nested too deeply", func(t *testing.T) {
+		resp := do(chainedFragments(65))
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code, resp.Body.String())
+		assert.JSONEq(t, `{"errors":[{"message":"fragments are nested too deeply, the maximum depth is 64","extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`, resp.Body.String())
+	})
+
+	t.Run("allows fragments up to the maximum depth", func(t *testing.T) {
+		resp := do(chainedFragments(64))
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+	})
+}
+
 func TestRecover(t *testing.T) {
 	srv := testserver.New()
 	srv.AddTransport(&panicTransport{})
@@ -24,6 +24,14 @@ func New() *TestServer {
 		type Query {
 			name: String!
 			find(id: Int!): String!
+			oldName: String! @deprecated(reason: "use name")
+			greet(style: Style): String!
+			names(first: Int, last: Int): [String!]!
+			tags: [String!]!
+		}
+		enum Style {
+			FORMAL
+			CASUAL @deprecated(reason: "too informal")
 		}
 		type Mutation {
 			name: String!
@@ -0,0 +1,42 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+)
+
+// BytesWrittenFunc is called with the number of bytes a transport wrote for an operation once it is done,
+// eg. for egress accounting. For streaming transports, such as SSE and websockets, it is the sum of all the
+// messages of the operation, including their protocol framing but before any websocket compression. ctx
+// carries the operation context, so the operation can be identified by its name or RequestID.
+type BytesWrittenFunc func(ctx context.Context, bytes int64)
+
+// WithBytesWrittenFunc sets the BytesWrittenFunc the transports report to for the operations of ctx.
+func WithBytesWrittenFunc(ctx context.Context, f BytesWrittenFunc) context.Context {
+	return context.WithValue(ctx, bytesWrittenFunc, f)
+}
+
+func reportBytesWritten(ctx context.Context, n int64) {
+	if f, ok := ctx.Value(bytesWrittenFunc).(BytesWrittenFunc); ok && f != nil {
+		f(ctx, n)
+	}
+}
+
+// countingResponseWriter counts the bytes written to a response that is flushed as it is written.
+type countingResponseWriter struct {
+	http.ResponseWriter
+	written atomic.Int64
+}
+
+func (w *countingResponseWriter) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	w.written.Add(int64(n))
+	return n, err
+}
+
+func (w *countingResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
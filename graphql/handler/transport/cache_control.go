@@ -0,0 +1,26 @@
+package transport
+
+import (
+	"net/http"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// cacheControlExtension is the response extension holding the cache policy of a response, it is set
+// by the CacheControl extension.
+const cacheControlExtension = "cacheControl"
+
+// setCacheControlHeader sets the Cache-Control header from the cacheControl extension of responses
+// without errors.
+func setCacheControlHeader(w http.ResponseWriter, resp *graphql.Response) {
+	if resp == nil || len(resp.Errors) != 0 {
+		return
+	}
+	policy, ok := resp.Extensions[cacheControlExtension].(interface{ HeaderValue() string })
+	if !ok {
+		return
+	}
+	if value := policy.HeaderValue(); value != "" {
+		w.Header().Set("Cache-Control", value)
+	}
+}
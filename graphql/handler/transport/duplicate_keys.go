@@ -0,0 +1,76 @@
+package transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+)
+
+// duplicateVariableKey returns the path of the first key repeated within an object of the variables of
+// a request body, eg. "input.name", or an empty string if there is none. Bodies that are not valid
+// JSON are left for the request decoder to report.
+func duplicateVariableKey(body []byte) string {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return ""
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return ""
+		}
+		if tok == "variables" {
+			path, _ := duplicateKey(dec, "")
+			return path
+		}
+		var skip json.RawMessage
+		if err := dec.Decode(&skip); err != nil {
+			return ""
+		}
+	}
+	return ""
+}
+
+// duplicateKey returns the path of the first key repeated within an object of the next JSON value
+// read by dec, or an empty string if there is none.
+func duplicateKey(dec *json.Decoder, path string) (string, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return "", err
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		seen := map[string]bool{}
+		for dec.More() {
+			tok, err := dec.Token()
+			if err != nil {
+				return "", err
+			}
+			key, _ := tok.(string)
+			keyPath := key
+			if path != "" {
+				keyPath = path + "." + key
+			}
+			if seen[key] {
+				return keyPath, nil
+			}
+			seen[key] = true
+			if dup, err := duplicateKey(dec, keyPath); err != nil || dup != "" {
+				return dup, err
+			}
+		}
+	case json.Delim('['):
+		for i := 0; dec.More(); i++ {
+			if dup, err := duplicateKey(dec, path+"."+strconv.Itoa(i)); err != nil || dup != "" {
+				return dup, err
+			}
+		}
+	default:
+		return "", nil
+	}
+
+	// the closing delimiter
+	_, err = dec.Token()
+	return "", err
+}
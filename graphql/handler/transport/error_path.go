@@ -0,0 +1,123 @@
+package transport
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+const (
+	// ErrorPathFormatHeader is the request header clients send to choose the format of error paths, on
+	// transports that allow it.
+	ErrorPathFormatHeader = "GraphQL-Error-Path-Format"
+	// ErrorPathFormatDotted serializes error paths as a dotted string, eg. "users.0.name", instead of
+	// the array of the spec.
+	ErrorPathFormatDotted = "dotted"
+
+	// ErrorLocationsHeader is the request header clients send to choose whether errors include their
+	// locations, on transports that allow it.
+	ErrorLocationsHeader = "GraphQL-Error-Locations"
+	// ErrorLocationsOmit leaves the locations out of errors, for clients that can't parse them.
+	ErrorLocationsOmit = "omit"
+
+	// ErrorCodeHeader is the request header clients send to choose where errors carry their code, on
+	// transports that allow it.
+	ErrorCodeHeader = "GraphQL-Error-Code"
+	// ErrorCodeTopLevel copies the code extension of errors to a top-level code field, for clients
+	// that expect it there. The code extension is kept as well.
+	ErrorCodeTopLevel = "top-level"
+)
+
+// errorFormatWriter marks a response writer of a client that asked for a custom error format, so
+// writeJson serializes the errors it writes with it.
+type errorFormatWriter struct {
+	http.ResponseWriter
+	dottedPaths   bool
+	omitLocations bool
+	topLevelCode  bool
+}
+
+func withErrorFormat(w http.ResponseWriter, r *http.Request, allowPathFormat, allowOmitLocations, allowTopLevelCode bool) http.ResponseWriter {
+	f := errorFormatWriter{
+		ResponseWriter: w,
+		dottedPaths:    allowPathFormat && headerEquals(r, ErrorPathFormatHeader, ErrorPathFormatDotted),
+		omitLocations:  allowOmitLocations && headerEquals(r, ErrorLocationsHeader, ErrorLocationsOmit),
+		topLevelCode:   allowTopLevelCode && headerEquals(r, ErrorCodeHeader, ErrorCodeTopLevel),
+	}
+	if !f.dottedPaths && !f.omitLocations && !f.topLevelCode {
+		return w
+	}
+	return f
+}
+
+func headerEquals(r *http.Request, header, value string) bool {
+	return strings.EqualFold(strings.TrimSpace(r.Header.Get(header)), value)
+}
+
+type formattedError struct {
+	*gqlerror.Error
+	// shadow the path and locations of the embedded error
+	Path      any                 `json:"path,omitempty"`
+	Locations []gqlerror.Location `json:"locations,omitempty"`
+	Code      any                 `json:"code,omitempty"`
+}
+
+// formattedResponse mirrors graphql.Response, keeping the errors first.
+type formattedResponse struct {
+	Errors     []formattedError `json:"errors,omitempty"`
+	Data       json.RawMessage  `json:"data"`
+	Label      string           `json:"label,omitempty"`
+	Path       ast.Path         `json:"path,omitempty"`
+	HasNext    *bool            `json:"hasNext,omitempty"`
+	Extensions map[string]any   `json:"extensions,omitempty"`
+}
+
+func (f errorFormatWriter) format(response *graphql.Response) *formattedResponse {
+	res := &formattedResponse{
+		Data:       response.Data,
+		Label:      response.Label,
+		Path:       response.Path,
+		HasNext:    response.HasNext,
+		Extensions: response.Extensions,
+	}
+	for _, err := range response.Errors {
+		formatted := formattedError{Error: err}
+		if f.dottedPaths {
+			if path := dottedPath(err.Path); path != "" {
+				formatted.Path = path
+			}
+		} else if len(err.Path) > 0 {
+			formatted.Path = err.Path
+		}
+		if !f.omitLocations {
+			formatted.Locations = err.Locations
+		}
+		if f.topLevelCode {
+			formatted.Code = err.Extensions["code"]
+		}
+		res.Errors = append(res.Errors, formatted)
+	}
+	return res
+}
+
+func dottedPath(path ast.Path) string {
+	var sb strings.Builder
+	for i, p := range path {
+		if i > 0 {
+			sb.WriteByte('.')
+		}
+		switch p := p.(type) {
+		case ast.PathIndex:
+			sb.WriteString(strconv.Itoa(int(p)))
+		case ast.PathName:
+			sb.WriteString(string(p))
+		}
+	}
+	return sb.String()
+}
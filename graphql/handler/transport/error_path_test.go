@@ -0,0 +1,220 @@
+package transport_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestErrorPathFormat(t *testing.T) {
+	newServer := func(tr graphql.Transport) http.Handler {
+		h := testserver.New()
+		h.AddTransport(tr)
+		h.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+			resp := next(ctx)
+			resp.Errors = append(resp.Errors, gqlerror.ErrorPathf(
+				ast.Path{ast.PathName("users"), ast.PathIndex(0), ast.PathName("friends"), ast.PathIndex(2), ast.PathName("name")},
+				"nested error",
+			))
+			return resp
+		})
+		return h
+	}
+
+	post := func(h http.Handler, format string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("POST", "/graphql", strings.NewReader(`{"query":"{ name }"}`))
+		r.Header.Set("Content-Type", "application/json")
+		if format != "" {
+			r.Header.Set(transport.ErrorPathFormatHeader, format)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+
+	get := func(h http.Handler, format string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("GET", "/graphql?query="+url.QueryEscape("{ name }"), http.NoBody)
+		if format != "" {
+			r.Header.Set(transport.ErrorPathFormatHeader, format)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+
+	const (
+		arrayPaths  = `{"errors":[{"message":"nested error","path":["users",0,"friends",2,"name"]}],"data":{"name":"test"}}`
+		dottedPaths = `{"errors":[{"message":"nested error","path":"users.0.friends.2.name"}],"data":{"name":"test"}}`
+	)
+
+	t.Run("POST defaults to arrays", func(t *testing.T) {
+		resp := post(newServer(transport.POST{AllowErrorPathFormat: true}), "")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.JSONEq(t, arrayPaths, resp.Body.String())
+	})
+
+	t.Run("POST with dotted paths", func(t *testing.T) {
+		resp := post(newServer(transport.POST{AllowErrorPathFormat: true}), transport.ErrorPathFormatDotted)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.JSONEq(t, dottedPaths, resp.Body.String())
+		assert.True(t, strings.HasPrefix(resp.Body.String(), `{"errors":`), "errors are serialized first")
+	})
+
+	t.Run("POST ignores the header unless allowed", func(t *testing.T) {
+		resp := post(newServer(transport.POST{}), transport.ErrorPathFormatDotted)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.JSONEq(t, arrayPaths, resp.Body.String())
+	})
+
+	t.Run("GET defaults to arrays", func(t *testing.T) {
+		resp := get(newServer(transport.GET{AllowErrorPathFormat: true}), "")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.JSONEq(t, arrayPaths, resp.Body.String())
+	})
+
+	t.Run("GET with dotted paths", func(t *testing.T) {
+		resp := get(newServer(transport.GET{AllowErrorPathFormat: true}), "Dotted")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.JSONEq(t, dottedPaths, resp.Body.String())
+	})
+
+	t.Run("validation errors use dotted paths", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.POST{AllowErrorPathFormat: true})
+		r := httptest.NewRequest("POST", "/graphql", strings.NewReader(`{"query":"query($id: Int!) { find(id: $id) }","variables":{"id":false}}`))
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set(transport.ErrorPathFormatHeader, transport.ErrorPathFormatDotted)
+		resp := httptest.NewRecorder()
+		h.ServeHTTP(resp, r)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+		assert.JSONEq(t, `{"errors":[{"message":"cannot use bool as Int","path":"variable.id","extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`, resp.Body.String())
+	})
+}
+
+func TestErrorLocations(t *testing.T) {
+	post := func(tr graphql.Transport, header string) *httptest.ResponseRecorder {
+		h := testserver.New()
+		h.AddTransport(tr)
+		r := httptest.NewRequest("POST", "/graphql", strings.NewReader(`{"query":"{ unknown }"}`))
+		r.Header.Set("Content-Type", "application/json")
+		if header != "" {
+			r.Header.Set(transport.ErrorLocationsHeader, header)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+
+	const (
+		withLocations    = `{"errors":[{"message":"Cannot query field \"unknown\" on type \"Query\".","locations":[{"line":1,"column":3}],"extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`
+		withoutLocations = `{"errors":[{"message":"Cannot query field \"unknown\" on type \"Query\".","extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`
+	)
+
+	t.Run("locations are included by default", func(t *testing.T) {
+		resp := post(transport.POST{AllowOmitErrorLocations: true}, "")
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+		assert.JSONEq(t, withLocations, resp.Body.String())
+	})
+
+	t.Run("locations are omitted on request", func(t *testing.T) {
+		resp := post(transport.POST{AllowOmitErrorLocations: true}, transport.ErrorLocationsOmit)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+		assert.JSONEq(t, withoutLocations, resp.Body.String())
+	})
+
+	t.Run("header is ignored unless allowed", func(t *testing.T) {
+		resp := post(transport.POST{}, transport.ErrorLocationsOmit)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+		assert.JSONEq(t, withLocations, resp.Body.String())
+	})
+
+	t.Run("combines with dotted paths", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.POST{AllowErrorPathFormat: true, AllowOmitErrorLocations: true})
+		h.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+			resp := next(ctx)
+			resp.Errors = append(resp.Errors, &gqlerror.Error{
+				Message:   "nested error",
+				Path:      ast.Path{ast.PathName("users"), ast.PathIndex(0)},
+				Locations: []gqlerror.Location{{Line: 1, Column: 3}},
+			})
+			return resp
+		})
+		r := httptest.NewRequest("POST", "/graphql", strings.NewReader(`{"query":"{ name }"}`))
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set(transport.ErrorPathFormatHeader, transport.ErrorPathFormatDotted)
+		r.Header.Set(transport.ErrorLocationsHeader, transport.ErrorLocationsOmit)
+		resp := httptest.NewRecorder()
+		h.ServeHTTP(resp, r)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.JSONEq(t, `{"errors":[{"message":"nested error","path":"users.0"}],"data":{"name":"test"}}`, resp.Body.String())
+	})
+}
+
+func TestTopLevelErrorCode(t *testing.T) {
+	post := func(tr graphql.Transport, header string) *httptest.ResponseRecorder {
+		h := testserver.New()
+		h.AddTransport(tr)
+		r := httptest.NewRequest("POST", "/graphql", strings.NewReader(`{"query":"{ unknown }"}`))
+		r.Header.Set("Content-Type", "application/json")
+		if header != "" {
+			r.Header.Set(transport.ErrorCodeHeader, header)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+
+	const (
+		extensionOnly = `{"errors":[{"message":"Cannot query field \"unknown\" on type \"Query\".","locations":[{"line":1,"column":3}],"extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`
+		topLevel      = `{"errors":[{"message":"Cannot query field \"unknown\" on type \"Query\".","locations":[{"line":1,"column":3}],"code":"GRAPHQL_VALIDATION_FAILED","extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`
+	)
+
+	t.Run("code is only an extension by default", func(t *testing.T) {
+		resp := post(transport.POST{AllowTopLevelErrorCode: true}, "")
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+		assert.JSONEq(t, extensionOnly, resp.Body.String())
+	})
+
+	t.Run("code is copied to the top level on request", func(t *testing.T) {
+		resp := post(transport.POST{AllowTopLevelErrorCode: true}, transport.ErrorCodeTopLevel)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+		assert.JSONEq(t, topLevel, resp.Body.String())
+	})
+
+	t.Run("header is ignored unless allowed", func(t *testing.T) {
+		resp := post(transport.POST{}, transport.ErrorCodeTopLevel)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+		assert.JSONEq(t, extensionOnly, resp.Body.String())
+	})
+
+	t.Run("errors without a code", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.GET{AllowTopLevelErrorCode: true})
+		h.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+			resp := next(ctx)
+			resp.Errors = append(resp.Errors, &gqlerror.Error{Message: "no code"}, &gqlerror.Error{
+				Message:    "with code",
+				Extensions: map[string]any{"code": "NOT_FOUND"},
+			})
+			return resp
+		})
+		r := httptest.NewRequest("GET", "/graphql?query={name}", nil)
+		r.Header.Set(transport.ErrorCodeHeader, transport.ErrorCodeTopLevel)
+		resp := httptest.NewRecorder()
+		h.ServeHTTP(resp, r)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.JSONEq(t, `{"errors":[{"message":"no code"},{"message":"with code","code":"NOT_FOUND","extensions":{"code":"NOT_FOUND"}}],"data":{"name":"test"}}`, resp.Body.String())
+	})
+}
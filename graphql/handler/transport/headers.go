@@ -60,12 +60,13 @@ func writeHeaders(w http.ResponseWriter, headers map[string][]string) {
 }
 
 func mergeHeaders(baseHeaders, additionalHeaders map[string][]string) map[string][]string {
+	// keys are canonicalized so a content-type header replaces the negotiated Content-Type
 	result := make(map[string][]string)
 	for k, v := range baseHeaders {
-		result[k] = v
+		result[http.CanonicalHeaderKey(k)] = v
 	}
 	for key, values := range additionalHeaders {
-		result[key] = values
+		result[http.CanonicalHeaderKey(key)] = values
 	}
 	return result
 }
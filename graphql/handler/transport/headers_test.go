@@ -8,10 +8,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"github.com/vektah/gqlparser/v2"
-	"github.com/vektah/gqlparser/v2/ast"
 
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/graphqltest"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/testserver"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
@@ -44,6 +43,21 @@ func TestHeadersWithPOST(t *testing.T) {
 		assert.Equal(t, "dummy-post", resp.Header().Get("Other-Header"))
 		assert.Equal(t, "another-one", resp.Header().Values("Other-Header")[1])
 	})
+
+	t.Run("Content-Type set exactly", func(t *testing.T) {
+		headers := map[string][]string{
+			"content-type": {"application/json; charset=utf-8"},
+		}
+
+		h := testserver.New()
+		h.AddTransport(transport.POST{ResponseHeaders: headers})
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`, "application/graphql-response+json", "application/json")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Len(t, resp.Header(), 1)
+		assert.Equal(t, []string{"application/json; charset=utf-8"}, resp.Header().Values("Content-Type"))
+		assert.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+	})
 }
 
 func TestHeadersWithGET(t *testing.T) {
@@ -132,19 +146,14 @@ func TestHeadersWithFormUrlEncoded(t *testing.T) {
 
 func TestHeadersWithMULTIPART(t *testing.T) {
 	t.Run("Headers not set", func(t *testing.T) {
-		es := &graphql.ExecutableSchemaMock{
-			ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
-				return graphql.OneShot(graphql.ErrorResponse(ctx, "not implemented"))
-			},
-			SchemaFunc: func() *ast.Schema {
-				return gqlparser.MustLoadSchema(&ast.Source{Input: `
-					type Mutation {
-						singleUpload(file: Upload!): String!
-					}
-					scalar Upload
-				`})
-			},
-		}
+		es := graphqltest.NewMockSchema(`
+			type Mutation {
+				singleUpload(file: Upload!): String!
+			}
+			scalar Upload
+		`, func(ctx context.Context) graphql.ResponseHandler {
+			return graphql.OneShot(graphql.ErrorResponse(ctx, "not implemented"))
+		})
 
 		h := handler.New(es)
 		h.AddTransport(transport.MultipartForm{})
@@ -173,19 +182,14 @@ func TestHeadersWithMULTIPART(t *testing.T) {
 	})
 
 	t.Run("Headers set", func(t *testing.T) {
-		es := &graphql.ExecutableSchemaMock{
-			ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
-				return graphql.OneShot(graphql.ErrorResponse(ctx, "not implemented"))
-			},
-			SchemaFunc: func() *ast.Schema {
-				return gqlparser.MustLoadSchema(&ast.Source{Input: `
-					type Mutation {
-						singleUpload(file: Upload!): String!
-					}
-					scalar Upload
-				`})
-			},
-		}
+		es := graphqltest.NewMockSchema(`
+			type Mutation {
+				singleUpload(file: Upload!): String!
+			}
+			scalar Upload
+		`, func(ctx context.Context) graphql.ResponseHandler {
+			return graphql.OneShot(graphql.ErrorResponse(ctx, "not implemented"))
+		})
 
 		h := handler.New(es)
 		headers := map[string][]string{
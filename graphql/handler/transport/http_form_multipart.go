@@ -24,6 +24,10 @@ type MultipartForm struct {
 	// Map of all headers that are added to graphql response. If not
 	// set, only one header: Content-Type: application/json will be set.
 	ResponseHeaders map[string][]string
+
+	// OnWriteError is called when writing the response fails, eg. because the client disconnected
+	// mid-response, see WriteErrorFunc.
+	OnWriteError WriteErrorFunc
 }
 
 var _ graphql.Transport = MultipartForm{}
@@ -56,6 +60,9 @@ func (f MultipartForm) maxMemory() int64 {
 }
 
 func (f MultipartForm) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecutor) {
+	ctx := r.Context()
+	w, reportWriteError := withWriteErrorFunc(w, f.OnWriteError)
+	defer func() { reportWriteError(ctx) }()
 	writeHeaders(w, f.ResponseHeaders)
 
 	start := graphql.Now()
@@ -217,6 +224,7 @@ func (f MultipartForm) Do(w http.ResponseWriter, r *http.Request, exec graphql.G
 		writeJson(w, resp)
 		return
 	}
-	responses, ctx := exec.DispatchOperation(r.Context(), rc)
-	writeJson(w, responses(ctx))
+	var responses graphql.ResponseHandler
+	responses, ctx = exec.DispatchOperation(ctx, rc)
+	reportBytesWritten(ctx, writeJson(w, responses(ctx)))
 }
@@ -17,6 +17,10 @@ type UrlEncodedForm struct {
 	// Map of all headers that are added to graphql response. If not
 	// set, only one header: Content-Type: application/json will be set.
 	ResponseHeaders map[string][]string
+
+	// OnWriteError is called when writing the response fails, eg. because the client disconnected
+	// mid-response, see WriteErrorFunc.
+	OnWriteError WriteErrorFunc
 }
 
 var _ graphql.Transport = UrlEncodedForm{}
@@ -36,6 +40,8 @@ func (h UrlEncodedForm) Supports(r *http.Request) bool {
 
 func (h UrlEncodedForm) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecutor) {
 	ctx := r.Context()
+	w, reportWriteError := withWriteErrorFunc(w, h.OnWriteError)
+	defer func() { reportWriteError(ctx) }()
 	writeHeaders(w, h.ResponseHeaders)
 	params := &graphql.RawParams{}
 	start := graphql.Now()
@@ -73,7 +79,7 @@ func (h UrlEncodedForm) Do(w http.ResponseWriter, r *http.Request, exec graphql.
 
 	var responses graphql.ResponseHandler
 	responses, ctx = exec.DispatchOperation(ctx, rc)
-	writeJson(w, responses(ctx))
+	reportBytesWritten(ctx, writeJson(w, responses(ctx)))
 }
 
 func (h UrlEncodedForm) parseBody(bodyString string) (*graphql.RawParams, error) {
@@ -3,6 +3,7 @@ package transport
 import (
 	"encoding/json"
 	"io"
+	"mime"
 	"net/http"
 	"net/url"
 	"strings"
@@ -17,9 +18,44 @@ import (
 // GET implements the GET side of the default HTTP transport
 // defined in https://github.com/APIs-guru/graphql-over-http#get
 type GET struct {
-	// Map of all headers that are added to graphql response. If not
-	// set, only one header: Content-Type: application/graphql-response+json will be set.
+	// Map of all headers that are added to graphql response. A Content-Type among them is sent
+	// exactly as given, eg. "application/json; charset=utf-8", instead of the one negotiated with
+	// the Accept header of the request.
 	ResponseHeaders map[string][]string
+
+	// AllowErrorPathFormat lets clients receive error paths as dotted strings by sending the
+	// ErrorPathFormatHeader with ErrorPathFormatDotted. Otherwise paths are always arrays.
+	AllowErrorPathFormat bool
+
+	// AllowOmitErrorLocations lets clients receive errors without their locations by sending the
+	// ErrorLocationsHeader with ErrorLocationsOmit. Otherwise locations are always included.
+	AllowOmitErrorLocations bool
+
+	// AllowTopLevelErrorCode lets clients receive the code extension of errors as a top-level code
+	// field as well, by sending the ErrorCodeHeader with ErrorCodeTopLevel.
+	AllowTopLevelErrorCode bool
+
+	// RejectDuplicateVariableKeys rejects requests whose variables repeat a key within an object, eg.
+	// {"id": 1, "id": 2}, instead of using the last value of the key.
+	RejectDuplicateVariableKeys bool
+
+	// InvalidRequestStatus replaces the HTTP status of responses to operations that fail to parse or
+	// validate, eg. http.StatusOK for clients that expect errors in the body of a successful
+	// response. When 0, they are answered with http.StatusUnprocessableEntity, or
+	// http.StatusBadRequest for application/graphql-response+json.
+	InvalidRequestStatus int
+
+	// SetRetryAfterHeader sends the retryAfter extension of request errors, such as the
+	// PersistedQueryNotFound error of the AutomaticPersistedQuery extension, as a Retry-After header.
+	SetRetryAfterHeader bool
+
+	// SetCacheControlHeader sends the cacheControl extension of responses without errors, added by
+	// the CacheControl extension, as a Cache-Control header.
+	SetCacheControlHeader bool
+
+	// OnWriteError is called when writing the response fails, eg. because the client disconnected
+	// mid-response, see WriteErrorFunc.
+	OnWriteError WriteErrorFunc
 }
 
 var _ graphql.Transport = GET{}
@@ -33,6 +69,12 @@ func (h GET) Supports(r *http.Request) bool {
 }
 
 func (h GET) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecutor) {
+	ctx := r.Context()
+	w, reportWriteError := withWriteErrorFunc(w, h.OnWriteError)
+	defer func() { reportWriteError(ctx) }()
+	if h.AllowErrorPathFormat || h.AllowOmitErrorLocations || h.AllowTopLevelErrorCode {
+		w = withErrorFormat(w, r, h.AllowErrorPathFormat, h.AllowOmitErrorLocations, h.AllowTopLevelErrorCode)
+	}
 	query, err := url.ParseQuery(r.URL.RawQuery)
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
@@ -56,6 +98,15 @@ func (h GET) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecut
 	raw.ReadTime.Start = graphql.Now()
 
 	if variables := query.Get("variables"); variables != "" {
+		if h.RejectDuplicateVariableKeys {
+			if key, _ := duplicateKey(json.NewDecoder(strings.NewReader(variables)), ""); key != "" {
+				w.WriteHeader(http.StatusBadRequest)
+				gqlErr := gqlerror.Errorf("variables contain the key %s more than once", key)
+				resp := exec.DispatchError(ctx, gqlerror.List{gqlErr})
+				writeJson(w, resp)
+				return
+			}
+		}
 		if err := jsonDecode(strings.NewReader(variables), &raw.Variables); err != nil {
 			w.WriteHeader(http.StatusBadRequest)
 			writeJsonError(w, "variables could not be decoded")
@@ -73,14 +124,13 @@ func (h GET) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecut
 
 	raw.ReadTime.End = graphql.Now()
 
-	opCtx, gqlError := exec.CreateOperationContext(r.Context(), raw)
+	opCtx, gqlError := exec.CreateOperationContext(ctx, raw)
 	if gqlError != nil {
-		if contentType == acceptApplicationGraphqlResponseJson {
-			w.WriteHeader(statusForGraphQLResponse(gqlError))
-		} else {
-			w.WriteHeader(statusFor(gqlError))
+		if h.SetRetryAfterHeader {
+			setRetryAfterHeader(w, gqlError)
 		}
-		resp := exec.DispatchError(graphql.WithOperationContext(r.Context(), opCtx), gqlError)
+		w.WriteHeader(requestErrorStatus(gqlError, contentType, h.InvalidRequestStatus))
+		resp := exec.DispatchError(graphql.WithOperationContext(ctx, opCtx), gqlError)
 		writeJson(w, resp)
 		return
 	}
@@ -91,8 +141,13 @@ func (h GET) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecut
 		return
 	}
 
-	responses, ctx := exec.DispatchOperation(r.Context(), opCtx)
-	writeJson(w, responses(ctx))
+	var responses graphql.ResponseHandler
+	responses, ctx = exec.DispatchOperation(ctx, opCtx)
+	resp := responses(ctx)
+	if h.SetCacheControlHeader {
+		setCacheControlHeader(w, resp)
+	}
+	reportBytesWritten(ctx, writeJson(w, resp))
 }
 
 func jsonDecode(r io.Reader, val any) error {
@@ -101,6 +156,18 @@ func jsonDecode(r io.Reader, val any) error {
 	return dec.Decode(val)
 }
 
+// requestErrorStatus returns the status of the response to an operation that couldn't be created,
+// invalidRequestStatus replacing the default one of parse and validation errors when set.
+func requestErrorStatus(errs gqlerror.List, contentType string, invalidRequestStatus int) int {
+	if invalidRequestStatus != 0 && errcode.GetErrorKind(errs) == errcode.KindProtocol {
+		return invalidRequestStatus
+	}
+	if mediaType, _, _ := mime.ParseMediaType(contentType); mediaType == acceptApplicationGraphqlResponseJson {
+		return statusForGraphQLResponse(errs)
+	}
+	return statusFor(errs)
+}
+
 func statusFor(errs gqlerror.List) int {
 	switch errcode.GetErrorKind(errs) {
 	case errcode.KindProtocol:
@@ -1,11 +1,14 @@
 package transport_test
 
 import (
+	"context"
 	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler/testserver"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 )
@@ -54,6 +57,24 @@ func TestGET(t *testing.T) {
 		assert.JSONEq(t, `{"errors":[{"message":"variables could not be decoded"}],"data":null}`, resp.Body.String())
 	})
 
+	t.Run("duplicate variable keys", func(t *testing.T) {
+		strict := testserver.New()
+		strict.AddTransport(transport.GET{RejectDuplicateVariableKeys: true})
+		strict.SetErrorPresenter(func(ctx context.Context, err error) *gqlerror.Error {
+			gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+			gqlErr.Extensions = map[string]any{"presented": true}
+			return gqlErr
+		})
+		target := `/graphql?query=query($id:Int!){find(id:$id)}&variables={"id":1,"id":2}`
+
+		resp := doRequest(strict, "GET", target, "", "application/json", "application/json")
+		assert.Equal(t, http.StatusBadRequest, resp.Code, resp.Body.String())
+		assert.JSONEq(t, `{"errors":[{"message":"variables contain the key id more than once","extensions":{"presented":true}}],"data":null}`, resp.Body.String())
+
+		resp = doRequest(h, "GET", target, "", "application/json", "application/json")
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+	})
+
 	t.Run("invalid variable", func(t *testing.T) {
 		resp := doRequest(h, "GET", `/graphql?query=query($id:Int!){find(id:$id)}&variables={"id":false}`, "", "", "application/json")
 		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code, resp.Body.String())
@@ -82,6 +103,16 @@ func TestGET(t *testing.T) {
 		assert.JSONEq(t, `{"errors":[{"message":"Unexpected !","locations":[{"line":1,"column":1}],"extensions":{"code":"GRAPHQL_PARSE_FAILED"}}],"data":null}`, resp.Body.String())
 	})
 
+	t.Run("parse failure with invalid request status", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.GET{InvalidRequestStatus: http.StatusOK})
+
+		resp := doRequest(h, "GET", "/graphql?query=!", "", "", "application/json")
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.Equal(t, "application/json", resp.Header().Get("Content-Type"))
+		assert.JSONEq(t, `{"errors":[{"message":"Unexpected !","locations":[{"line":1,"column":1}],"extensions":{"code":"GRAPHQL_PARSE_FAILED"}}],"data":null}`, resp.Body.String())
+	})
+
 	t.Run("no mutations", func(t *testing.T) {
 		resp := doRequest(h, "GET", "/graphql?query=mutation{name}", "", "", "application/json")
 		assert.Equal(t, http.StatusNotAcceptable, resp.Code, resp.Body.String())
@@ -19,6 +19,10 @@ type GRAPHQL struct {
 	// Map of all headers that are added to graphql response. If not
 	// set, only one header: Content-Type: application/json will be set.
 	ResponseHeaders map[string][]string
+
+	// OnWriteError is called when writing the response fails, eg. because the client disconnected
+	// mid-response, see WriteErrorFunc.
+	OnWriteError WriteErrorFunc
 }
 
 var _ graphql.Transport = GRAPHQL{}
@@ -38,6 +42,8 @@ func (h GRAPHQL) Supports(r *http.Request) bool {
 
 func (h GRAPHQL) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecutor) {
 	ctx := r.Context()
+	w, reportWriteError := withWriteErrorFunc(w, h.OnWriteError)
+	defer func() { reportWriteError(ctx) }()
 	writeHeaders(w, h.ResponseHeaders)
 	params := &graphql.RawParams{}
 	start := graphql.Now()
@@ -74,7 +80,7 @@ func (h GRAPHQL) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphEx
 
 	var responses graphql.ResponseHandler
 	responses, ctx = exec.DispatchOperation(ctx, rc)
-	writeJson(w, responses(ctx))
+	reportBytesWritten(ctx, writeJson(w, responses(ctx)))
 }
 
 // Makes sure we strip "query=" keyword from body and
@@ -20,6 +20,10 @@ import (
 type MultipartMixed struct {
 	Boundary        string
 	DeliveryTimeout time.Duration
+
+	// OnWriteError is called when writing the parts of the response fails, eg. because the client
+	// disconnected, see WriteErrorFunc.
+	OnWriteError WriteErrorFunc
 }
 
 var _ graphql.Transport = MultipartMixed{}
@@ -58,6 +62,8 @@ func (t MultipartMixed) Do(w http.ResponseWriter, r *http.Request, exec graphql.
 		return
 	}
 	defer flusher.Flush()
+	w, reportWriteError := withWriteErrorFunc(w, t.OnWriteError)
+	defer func() { reportWriteError(ctx) }()
 
 	w.Header().Set("Cache-Control", "no-cache")
 	w.Header().Set("Connection", "keep-alive")
@@ -110,7 +116,7 @@ func (t MultipartMixed) Do(w http.ResponseWriter, r *http.Request, exec graphql.
 	if opErr != nil {
 		w.WriteHeader(statusFor(opErr))
 
-		resp := exec.DispatchError(ctx, opErr)
+		resp := exec.DispatchError(graphql.WithOperationContext(ctx, rc), opErr)
 		writeJson(w, resp)
 		return
 	}
@@ -135,8 +141,11 @@ func (t MultipartMixed) Do(w http.ResponseWriter, r *http.Request, exec graphql.
 		fmt.Sprintf(`multipart/mixed;boundary="%s";deferSpec=20220824`, boundary),
 	)
 
-	a := newMultipartResponseAggregator(w, boundary, timeout)
-	defer a.Done(w)
+	cw := &countingResponseWriter{ResponseWriter: w}
+	defer func() { reportBytesWritten(ctx, cw.written.Load()) }()
+
+	a := newMultipartResponseAggregator(cw, boundary, timeout)
+	defer a.Done(cw)
 
 	responses, ctx := exec.DispatchOperation(ctx, rc)
 	initialResponse := true
@@ -2,12 +2,14 @@ package transport
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"mime"
 	"net/http"
 	"sync"
 
+	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
 	"github.com/99designs/gqlgen/graphql"
@@ -16,9 +18,56 @@ import (
 // POST implements the POST side of the default HTTP transport
 // defined in https://github.com/APIs-guru/graphql-over-http#post
 type POST struct {
-	// Map of all headers that are added to graphql response. If not
-	// set, only one header: Content-Type: application/graphql-response+json will be set.
+	// Map of all headers that are added to graphql response. A Content-Type among them is sent
+	// exactly as given, eg. "application/json; charset=utf-8", instead of the one negotiated with
+	// the Accept header of the request.
 	ResponseHeaders map[string][]string
+
+	// DisallowSubscriptions rejects subscription operations. Otherwise the first value emitted by
+	// the subscription is returned as a normal response, after which the subscription is cancelled.
+	DisallowSubscriptions bool
+
+	// AllowErrorPathFormat lets clients receive error paths as dotted strings by sending the
+	// ErrorPathFormatHeader with ErrorPathFormatDotted. Otherwise paths are always arrays.
+	AllowErrorPathFormat bool
+
+	// AllowOmitErrorLocations lets clients receive errors without their locations by sending the
+	// ErrorLocationsHeader with ErrorLocationsOmit. Otherwise locations are always included.
+	AllowOmitErrorLocations bool
+
+	// AllowTopLevelErrorCode lets clients receive the code extension of errors as a top-level code
+	// field as well, by sending the ErrorCodeHeader with ErrorCodeTopLevel.
+	AllowTopLevelErrorCode bool
+
+	// RejectDuplicateVariableKeys rejects requests whose variables repeat a key within an object, eg.
+	// {"id": 1, "id": 2}, instead of using the last value of the key.
+	RejectDuplicateVariableKeys bool
+
+	// InvalidRequestStatus replaces the HTTP status of responses to operations that fail to parse or
+	// validate, eg. http.StatusOK for clients that expect errors in the body of a successful
+	// response. When 0, they are answered with http.StatusUnprocessableEntity, or
+	// http.StatusBadRequest for application/graphql-response+json.
+	InvalidRequestStatus int
+
+	// SetRetryAfterHeader sends the retryAfter extension of request errors, such as the
+	// PersistedQueryNotFound error of the AutomaticPersistedQuery extension, as a Retry-After header.
+	SetRetryAfterHeader bool
+
+	// SetCacheControlHeader sends the cacheControl extension of responses without errors, added by
+	// the CacheControl extension, as a Cache-Control header.
+	SetCacheControlHeader bool
+
+	// RequestBodySink receives the exact bytes of every request body, eg. to store them for auditing.
+	// It is called synchronously before the body is parsed, and must not modify body.
+	RequestBodySink func(ctx context.Context, body []byte)
+
+	// RequestBodySinkLimit truncates the bodies passed to RequestBodySink to this many bytes, the
+	// request itself is still parsed in full. When 0, bodies are passed whole.
+	RequestBodySinkLimit int
+
+	// OnWriteError is called when writing the response fails, eg. because the client disconnected
+	// mid-response, see WriteErrorFunc.
+	OnWriteError WriteErrorFunc
 }
 
 var _ graphql.Transport = POST{}
@@ -55,6 +104,8 @@ var pool = sync.Pool{
 
 func (h POST) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecutor) {
 	ctx := r.Context()
+	w, reportWriteError := withWriteErrorFunc(w, h.OnWriteError)
+	defer func() { reportWriteError(ctx) }()
 	contentType := determineResponseContentType(h.ResponseHeaders, r)
 	responseHeaders := mergeHeaders(
 		map[string][]string{
@@ -63,6 +114,9 @@ func (h POST) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecu
 		h.ResponseHeaders,
 	)
 	writeHeaders(w, responseHeaders)
+	if h.AllowErrorPathFormat || h.AllowOmitErrorLocations || h.AllowTopLevelErrorCode {
+		w = withErrorFormat(w, r, h.AllowErrorPathFormat, h.AllowOmitErrorLocations, h.AllowTopLevelErrorCode)
+	}
 	params := pool.Get().(*graphql.RawParams)
 	defer func() {
 		params.Headers = nil
@@ -90,6 +144,24 @@ func (h POST) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecu
 		return
 	}
 
+	if h.RequestBodySink != nil {
+		sunk := bodyBytes
+		if h.RequestBodySinkLimit > 0 && len(sunk) > h.RequestBodySinkLimit {
+			sunk = sunk[:h.RequestBodySinkLimit]
+		}
+		h.RequestBodySink(ctx, sunk)
+	}
+
+	if h.RejectDuplicateVariableKeys {
+		if key := duplicateVariableKey(bodyBytes); key != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			gqlErr := gqlerror.Errorf("variables contain the key %s more than once", key)
+			resp := exec.DispatchError(ctx, gqlerror.List{gqlErr})
+			writeJson(w, resp)
+			return
+		}
+	}
+
 	bodyReader := bytes.NewReader(bodyBytes)
 	if err := jsonDecode(bodyReader, &params); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
@@ -105,17 +177,35 @@ func (h POST) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecu
 
 	rc, opErr := exec.CreateOperationContext(ctx, params)
 	if opErr != nil {
-		if contentType == acceptApplicationGraphqlResponseJson {
-			w.WriteHeader(statusForGraphQLResponse(opErr))
-		} else {
-			w.WriteHeader(statusFor(opErr))
+		if h.SetRetryAfterHeader {
+			setRetryAfterHeader(w, opErr)
 		}
+		w.WriteHeader(requestErrorStatus(opErr, contentType, h.InvalidRequestStatus))
 		resp := exec.DispatchError(graphql.WithOperationContext(ctx, rc), opErr)
 		writeJson(w, resp)
 		return
 	}
 
+	if rc.Operation.Operation == ast.Subscription {
+		if h.DisallowSubscriptions {
+			w.WriteHeader(http.StatusNotAcceptable)
+			writeJsonError(w, "POST requests only allow query and mutation operations")
+			return
+		}
+
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+	}
+
 	var responses graphql.ResponseHandler
 	responses, ctx = exec.DispatchOperation(ctx, rc)
-	writeJson(w, responses(ctx))
+	resp := responses(ctx)
+	if resp == nil {
+		resp = exec.DispatchError(ctx, gqlerror.List{gqlerror.Errorf("subscription completed without a value")})
+	}
+	if h.SetCacheControlHeader {
+		setCacheControlHeader(w, resp)
+	}
+	reportBytesWritten(ctx, writeJson(w, resp))
 }
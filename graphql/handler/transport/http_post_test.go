@@ -1,11 +1,13 @@
 package transport_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -73,6 +75,81 @@ func TestPOST(t *testing.T) {
 		assert.JSONEq(t, `{"errors":[{"message":"Unexpected !","locations":[{"line":1,"column":1}],"extensions":{"code":"GRAPHQL_PARSE_FAILED"}}],"data":null}`, resp.Body.String())
 	})
 
+	t.Run("parse failure with invalid request status", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.POST{InvalidRequestStatus: http.StatusOK})
+
+		resp := doRequest(h, "POST", "/graphql", `{"query": "!"}`, "", "application/json")
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.Equal(t, "application/json", resp.Header().Get("Content-Type"))
+		assert.JSONEq(t, `{"errors":[{"message":"Unexpected !","locations":[{"line":1,"column":1}],"extensions":{"code":"GRAPHQL_PARSE_FAILED"}}],"data":null}`, resp.Body.String())
+
+		resp = doRequest(h, "POST", "/graphql", `{"query": "!"}`, "application/graphql-response+json", "application/json")
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.JSONEq(t, `{"errors":[{"message":"Unexpected !","locations":[{"line":1,"column":1}],"extensions":{"code":"GRAPHQL_PARSE_FAILED"}}],"data":null}`, resp.Body.String())
+
+		resp = doRequest(h, "POST", "/graphql", `{"query": "{ title }"}`, "", "application/json")
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.JSONEq(t, `{"errors":[{"message":"Cannot query field \"title\" on type \"Query\".","locations":[{"line":1,"column":3}],"extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`, resp.Body.String())
+	})
+
+	t.Run("request body sink", func(t *testing.T) {
+		var sunk [][]byte
+		h := testserver.New()
+		h.AddTransport(transport.POST{RequestBodySink: func(ctx context.Context, body []byte) {
+			sunk = append(sunk, body)
+		}})
+
+		body := "{\"query\":  \"{ name }\",\n \"variables\": {}}"
+		resp := doRequest(h, "POST", "/graphql", body, "", "application/json")
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+
+		resp = doRequest(h, "POST", "/graphql", `{"query": "!"}`, "", "application/json")
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code, resp.Body.String())
+
+		assert.Equal(t, [][]byte{[]byte(body), []byte(`{"query": "!"}`)}, sunk)
+	})
+
+	t.Run("request body sink with limit", func(t *testing.T) {
+		var sunk []byte
+		h := testserver.New()
+		h.AddTransport(transport.POST{
+			RequestBodySink: func(ctx context.Context, body []byte) {
+				sunk = body
+			},
+			RequestBodySinkLimit: 10,
+		})
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`, "", "application/json")
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+		assert.Equal(t, `{"query":"`, string(sunk))
+	})
+
+	t.Run("duplicate variable keys", func(t *testing.T) {
+		strict := testserver.New()
+		strict.AddTransport(transport.POST{RejectDuplicateVariableKeys: true})
+
+		body := `{"query":"query($id:Int!){find(id:$id)}","variables":{"id":1,"id":2}}`
+		resp := doRequest(strict, "POST", "/graphql", body, "", "application/json")
+		assert.Equal(t, http.StatusBadRequest, resp.Code, resp.Body.String())
+		assert.JSONEq(t, `{"errors":[{"message":"variables contain the key id more than once"}],"data":null}`, resp.Body.String())
+
+		nested := `{"query":"query($id:Int!){find(id:$id)}","variables":{"id":1,"filter":[{"a":1},{"a":1,"b":{"c":1,"c":2}}]}}`
+		resp = doRequest(strict, "POST", "/graphql", nested, "", "application/json")
+		assert.Equal(t, http.StatusBadRequest, resp.Code, resp.Body.String())
+		assert.JSONEq(t, `{"errors":[{"message":"variables contain the key filter.1.b.c more than once"}],"data":null}`, resp.Body.String())
+
+		// keys repeated in separate objects or outside of the variables are fine
+		unique := `{"query":"query($id:Int!){find(id:$id)}","variables":{"id":1,"filter":[{"a":1},{"a":1}]},"extensions":{"x":1,"x":2}}`
+		resp = doRequest(strict, "POST", "/graphql", unique, "", "application/json")
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+		resp = doRequest(h, "POST", "/graphql", body, "", "application/json")
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+	})
+
 	t.Run("validation failure", func(t *testing.T) {
 		resp := doRequest(h, "POST", "/graphql", `{"query": "{ title }"}`, "", "application/json")
 		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code, resp.Body.String())
@@ -87,6 +164,14 @@ func TestPOST(t *testing.T) {
 		assert.JSONEq(t, `{"errors":[{"message":"Cannot query field \"title\" on type \"Query\".","locations":[{"line":1,"column":3}],"extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`, resp.Body.String())
 	})
 
+	t.Run("empty query", func(t *testing.T) {
+		for _, body := range []string{`{"query": ""}`, `{"query": " \n "}`, `{}`} {
+			resp := doRequest(h, "POST", "/graphql", body, "", "application/json")
+			assert.Equal(t, http.StatusUnprocessableEntity, resp.Code, resp.Body.String())
+			assert.JSONEq(t, `{"errors":[{"message":"empty query, the request must contain a GraphQL document","extensions":{"code":"GRAPHQL_EMPTY_QUERY"}}],"data":null}`, resp.Body.String())
+		}
+	})
+
 	t.Run("invalid variable", func(t *testing.T) {
 		resp := doRequest(h, "POST", "/graphql", `{"query": "query($id:Int!){find(id:$id)}","variables":{"id":false}}`, "", "application/json")
 		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code, resp.Body.String())
@@ -182,3 +267,54 @@ func doRequest(handler http.Handler, method, target, body, accept, contentType s
 	handler.ServeHTTP(w, r)
 	return w
 }
+
+func TestPOSTSubscription(t *testing.T) {
+	t.Run("rejected when disallowed", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.POST{DisallowSubscriptions: true})
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"subscription { name }"}`, "application/json", "application/json")
+		assert.Equal(t, http.StatusNotAcceptable, resp.Code, resp.Body.String())
+		assert.JSONEq(t, `{"errors":[{"message":"POST requests only allow query and mutation operations"}],"data":null}`, resp.Body.String())
+	})
+
+	t.Run("returns the first value by default", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.POST{})
+
+		done := make(chan *httptest.ResponseRecorder)
+		go func() {
+			done <- doRequest(h, "POST", "/graphql", `{"query":"subscription { name }"}`, "application/json", "application/json")
+		}()
+
+		h.SendNextSubscriptionMessage()
+
+		select {
+		case resp := <-done:
+			assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+			assert.JSONEq(t, `{"data":{"name":"test"}}`, resp.Body.String())
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for the subscription response")
+		}
+	})
+
+	t.Run("subscription completing without a value", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.POST{})
+
+		done := make(chan *httptest.ResponseRecorder)
+		go func() {
+			done <- doRequest(h, "POST", "/graphql", `{"query":"subscription { name }"}`, "application/json", "application/json")
+		}()
+
+		h.SendCompleteSubscriptionMessage()
+
+		select {
+		case resp := <-done:
+			assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+			assert.JSONEq(t, `{"errors":[{"message":"subscription completed without a value"}],"data":null}`, resp.Body.String())
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for the subscription response")
+		}
+	})
+}
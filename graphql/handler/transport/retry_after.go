@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// retryAfterExtension is the error extension holding the number of seconds a client should wait
+// before retrying, it is set by the AutomaticPersistedQuery extension.
+const retryAfterExtension = "retryAfter"
+
+// setRetryAfterHeader sets the Retry-After header from the first error with a retryAfter extension.
+// The header only supports whole seconds, so the delay is rounded up.
+func setRetryAfterHeader(w http.ResponseWriter, errs gqlerror.List) {
+	for _, err := range errs {
+		var seconds float64
+		switch v := err.Extensions[retryAfterExtension].(type) {
+		case float64:
+			seconds = v
+		case int:
+			seconds = float64(v)
+		default:
+			continue
+		}
+		if seconds <= 0 {
+			continue
+		}
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(seconds))))
+		return
+	}
+}
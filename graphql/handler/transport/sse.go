@@ -20,6 +20,10 @@ import (
 type (
 	SSE struct {
 		KeepAlivePingInterval time.Duration
+
+		// OnWriteError is called when writing the events fails, eg. because the client disconnected,
+		// see WriteErrorFunc.
+		OnWriteError WriteErrorFunc
 	}
 
 	sseConnection struct {
@@ -50,6 +54,8 @@ func (t SSE) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecut
 		SendErrorf(w, http.StatusInternalServerError, "streaming unsupported")
 		return
 	}
+	w, reportWriteError := withWriteErrorFunc(w, t.OnWriteError)
+	defer func() { reportWriteError(ctx) }()
 
 	c := &sseConnection{
 		ctx: ctx,
@@ -110,23 +116,28 @@ func (t SSE) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecut
 	}
 
 	if opErr != nil {
-		resp := exec.DispatchError(ctx, opErr)
+		resp := exec.DispatchError(graphql.WithOperationContext(ctx, rc), opErr)
 		writeJsonWithSSE(w, resp)
-	} else {
-		responses, ctx := exec.DispatchOperation(ctx, rc)
-		for {
-			response := responses(ctx)
-			if response == nil {
-				break
-			}
-			writeJsonWithSSE(w, response)
-			c.flush()
+		fmt.Fprint(w, "event: complete\n\n")
+		return
+	}
 
-			c.resetTicker(t.KeepAlivePingInterval)
+	var responses graphql.ResponseHandler
+	responses, ctx = exec.DispatchOperation(ctx, rc)
+	var written int64
+	for {
+		response := responses(ctx)
+		if response == nil {
+			break
 		}
+		written += writeJsonWithSSE(w, response)
+		c.flush()
+
+		c.resetTicker(t.KeepAlivePingInterval)
 	}
 
-	fmt.Fprint(w, "event: complete\n\n")
+	n, _ := fmt.Fprint(w, "event: complete\n\n")
+	reportBytesWritten(ctx, written+int64(n))
 }
 
 func (c *sseConnection) resetTicker(interval time.Duration) {
@@ -156,10 +167,11 @@ func (c *sseConnection) flush() {
 	c.mu.Unlock()
 }
 
-func writeJsonWithSSE(w io.Writer, response *graphql.Response) {
+func writeJsonWithSSE(w io.Writer, response *graphql.Response) int64 {
 	b, err := json.Marshal(response)
 	if err != nil {
 		panic(err)
 	}
-	fmt.Fprintf(w, "event: next\ndata: %s\n\n", b)
+	n, _ := fmt.Fprintf(w, "event: next\ndata: %s\n\n", b)
+	return int64(n)
 }
@@ -2,6 +2,7 @@ package transport_test
 
 import (
 	"bufio"
+	"context"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -10,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler/testserver"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/stretchr/testify/assert"
@@ -203,4 +205,49 @@ func TestSSE(t *testing.T) {
 
 		wg.Wait()
 	})
+
+	t.Run("client disconnect cancels the operation", func(t *testing.T) {
+		handler, srv := initializeWithServer()
+		defer srv.Close()
+
+		operations := make(chan context.Context, 1)
+		responses := make(chan *graphql.Response, 1)
+		handler.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+			operations <- ctx
+			return next(ctx)
+		})
+		handler.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+			resp := next(ctx)
+			if resp != nil {
+				responses <- resp
+			}
+			return resp
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		req := createHTTPRequest(srv.URL, `{"query":"subscription { name }"}`).WithContext(ctx)
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err, "Request threw error -> %s", err)
+		defer func() {
+			_ = res.Body.Close()
+		}()
+
+		br := bufio.NewReader(res.Body)
+		assert.Equal(t, ":\n", readLine(br))
+		assert.Equal(t, "\n", readLine(br))
+
+		opCtx := <-operations
+		handler.SendNextSubscriptionMessage()
+		assert.Equal(t, "event: next\n", readLine(br))
+		assert.Equal(t, "data: {\"data\":{\"name\":\"test\"}}\n", readLine(br))
+		assert.JSONEq(t, `{"name":"test"}`, string((<-responses).Data))
+
+		cancel()
+		select {
+		case <-opCtx.Done():
+		case <-time.After(time.Second):
+			assert.Fail(t, "the operation was not cancelled in time")
+		}
+	})
 }
@@ -10,12 +10,19 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 )
 
-func writeJson(w io.Writer, response *graphql.Response) {
-	b, err := json.Marshal(response)
+func writeJson(w io.Writer, response *graphql.Response) int64 {
+	var b []byte
+	var err error
+	if f, ok := w.(errorFormatWriter); ok {
+		b, err = json.Marshal(f.format(response))
+	} else {
+		b, err = json.Marshal(response)
+	}
 	if err != nil {
 		panic(fmt.Errorf("unable to marshal %s: %w", string(response.Data), err))
 	}
-	w.Write(b)
+	n, _ := w.Write(b)
+	return int64(n)
 }
 
 func writeJsonError(w io.Writer, msg string) {
@@ -21,14 +21,20 @@ import (
 
 type (
 	Websocket struct {
-		Upgrader              websocket.Upgrader
-		InitFunc              WebsocketInitFunc
-		InitTimeout           time.Duration
-		ErrorFunc             WebsocketErrorFunc
-		CloseFunc             WebsocketCloseFunc
-		KeepAlivePingInterval time.Duration
-		PongOnlyInterval      time.Duration
-		PingPongInterval      time.Duration
+		Upgrader          websocket.Upgrader
+		InitFunc          WebsocketInitFunc
+		ConnectionAckFunc WebsocketConnectionAckFunc
+		InitTimeout       time.Duration
+		// InitTimeoutCloseCode and InitTimeoutCloseReason are sent when closing a connection that did
+		// not send an init message within InitTimeout. They default to 1002 (protocol error) and
+		// "connection initialisation timeout".
+		InitTimeoutCloseCode   int
+		InitTimeoutCloseReason string
+		ErrorFunc              WebsocketErrorFunc
+		CloseFunc              WebsocketCloseFunc
+		KeepAlivePingInterval  time.Duration
+		PongOnlyInterval       time.Duration
+		PingPongInterval       time.Duration
 		/* If PingPongInterval has a non-0 duration, then when the server sends a ping
 		 * it sets a ReadDeadline of PingPongInterval*2 and if the client doesn't respond
 		 * with pong before that deadline is reached then the connection will die with a
@@ -39,6 +45,54 @@ type (
 		 */
 		MissingPongOk bool
 
+		// KeepAliveOnlyWhenIdle restarts the KeepAlivePingInterval with each data message sent, so
+		// graphql-ws keep-alives are only sent while no data flows.
+		KeepAliveOnlyWhenIdle bool
+
+		// SubscriptionBufferSize is the number of responses of each subscription read ahead of the
+		// socket writer, so a bursty resolver isn't blocked while earlier responses are written. When
+		// 0, the next response is only read once the previous one has been written.
+		SubscriptionBufferSize int
+
+		// FlowControl makes subscriptions only send a response once the client has requested it, and
+		// read at most one response from their resolver ahead of the requests, so little is produced
+		// or buffered ahead of a slow client. Clients request responses with a request message for the
+		// subscription, eg. {"id":"1","type":"request","payload":{"n":10}}, and a subscription starts
+		// with none. Credit is only used by next messages, the complete message is sent as soon as the
+		// resolver is done. SubscriptionBufferSize is ignored when it is set.
+		FlowControl bool
+
+		// MessageCodec encodes the messages exchanged with clients, eg. with MessagePack for frames
+		// smaller than JSON. When set, messages are written in binary frames encoded with it, and binary
+		// frames are decoded with it. Text frames are still read as JSON. When nil, messages are written
+		// in JSON text frames, and binary frames are read as JSON too.
+		MessageCodec WebsocketMessageCodec
+
+		// MaxMessageSize is the maximum size in bytes of the messages read from clients, eg. to stop
+		// clients from exhausting memory with huge subscribe payloads. A client sending a larger
+		// message is sent a connection_error, when its subprotocol has one, and the connection is
+		// closed with 1009 (message too big). ErrorFunc is called with a WebsocketError whose
+		// IsReadLimitError is set. When 0, messages of any size are read.
+		MaxMessageSize int64
+
+		// OnOperation is called for each operation started by a client, once it has been parsed and
+		// validated and before it is executed, eg. to deny subscriptions selecting some fields. When it
+		// returns an error, the error is sent to the client for that operation, which is not started.
+		// The connection stays open.
+		OnOperation WebsocketOperationFunc
+
+		// MaxSubscriptionsPerConnection is the maximum number of operations a client can run at the
+		// same time on a connection, including stopped operations that haven't finished yet. Operations
+		// started past it are answered with an error message and are not run. When 0, the number of
+		// operations isn't limited.
+		MaxSubscriptionsPerConnection int
+
+		// WriteTimeout is the deadline of each message written to a client, so a client that stops
+		// reading can't block the connection forever once its buffers are full. On a timeout, the
+		// connection is closed and ErrorFunc is called with a WebsocketError whose IsWriteError is set.
+		// When 0, writes have no deadline.
+		WriteTimeout time.Duration
+
 		didInjectSubprotocols bool
 	}
 	wsConnection struct {
@@ -47,6 +101,7 @@ type (
 		conn            *websocket.Conn
 		me              messageExchanger
 		active          map[string]context.CancelFunc
+		credits         map[string]*subscriptionCredit
 		mu              sync.Mutex
 		keepAliveTicker *time.Ticker
 		pongOnlyTicker  *time.Ticker
@@ -54,16 +109,49 @@ type (
 		receivedPong    bool
 		exec            graphql.GraphExecutor
 		closed          bool
+		draining        bool
+		subscriptions   sync.WaitGroup
 		headers         http.Header
 
 		initPayload InitPayload
+
+		// running counts the operations whose goroutine hasn't exited yet, including stopped ones that
+		// are still finishing, for MaxSubscriptionsPerConnection.
+		running int
 	}
 
 	WebsocketInitFunc  func(ctx context.Context, initPayload InitPayload) (context.Context, *InitPayload, error)
 	WebsocketErrorFunc func(ctx context.Context, err error)
 
+	// WebsocketConnectionError can be returned by InitFunc or ConnectionAckFunc to send structured
+	// details to graphql-ws clients, the payload becomes the payload of the connection_error message
+	// instead of the error message alone.
+	WebsocketConnectionError interface {
+		error
+		ConnectionErrorPayload() map[string]any
+	}
+
+	// WebsocketConnectionAckFunc computes values to advertise in the connection_ack payload, such as
+	// server capabilities. It is called after InitFunc, and the returned values are merged into any
+	// payload returned by InitFunc, taking precedence over it.
+	WebsocketConnectionAckFunc func(ctx context.Context, initPayload InitPayload) (map[string]any, error)
+
 	// Callback called when websocket is closed.
 	WebsocketCloseFunc func(ctx context.Context, closeCode int)
+
+	// WebsocketOperationFunc is called before an operation started over the websocket is executed,
+	// returning an error rejects it.
+	WebsocketOperationFunc func(ctx context.Context, oc *graphql.OperationContext) error
+
+	// WebsocketMessageCodec encodes and decodes the messages exchanged in binary frames. Marshal is
+	// given each message, including its payload, as the values JSON decodes to, with integers as int64:
+	// map[string]any, []any, string, int64, float64, bool and nil, eg.
+	// map[string]any{"type": "next", "id": "1", "payload": ...}. Unmarshal decodes a binary frame into
+	// a *any, with values that encoding/json can encode.
+	WebsocketMessageCodec interface {
+		Marshal(v any) ([]byte, error)
+		Unmarshal(data []byte, v any) error
+	}
 )
 
 var errReadTimeout = errors.New("read timeout")
@@ -73,6 +161,12 @@ type WebsocketError struct {
 
 	// IsReadError flags whether the error occurred on read or write to the websocket
 	IsReadError bool
+
+	// IsReadLimitError flags read errors caused by a message larger than MaxMessageSize
+	IsReadLimitError bool
+
+	// IsWriteError flags errors that occurred writing to the websocket, eg. a WriteTimeout
+	IsWriteError bool
 }
 
 func (e WebsocketError) Error() string {
@@ -101,7 +195,8 @@ func (t Websocket) Do(w http.ResponseWriter, r *http.Request, exec graphql.Graph
 	}
 
 	var me messageExchanger
-	switch ws.Subprotocol() {
+	subprotocol := ws.Subprotocol()
+	switch subprotocol {
 	default:
 		msg := websocket.FormatCloseMessage(websocket.CloseProtocolError, fmt.Sprintf("unsupported negotiated subprotocol %s", ws.Subprotocol()))
 		_ = ws.WriteMessage(websocket.CloseMessage, msg)
@@ -109,21 +204,25 @@ func (t Websocket) Do(w http.ResponseWriter, r *http.Request, exec graphql.Graph
 	case graphqlwsSubprotocol, "":
 		// clients are required to send a subprotocol, to be backward compatible with the previous implementation we select
 		// "graphql-ws" by default
-		me = graphqlwsMessageExchanger{c: ws}
+		subprotocol = graphqlwsSubprotocol
+		me = graphqlwsMessageExchanger{c: ws, codec: t.MessageCodec, maxMessageSize: t.MaxMessageSize}
 	case graphqltransportwsSubprotocol:
-		me = graphqltransportwsMessageExchanger{c: ws}
+		me = graphqltransportwsMessageExchanger{c: ws, codec: t.MessageCodec, maxMessageSize: t.MaxMessageSize}
 	}
 
 	conn := wsConnection{
 		active:    map[string]context.CancelFunc{},
+		credits:   map[string]*subscriptionCredit{},
 		conn:      ws,
-		ctx:       r.Context(),
+		ctx:       withInitRequest(withSubprotocol(r.Context(), subprotocol), r),
 		exec:      exec,
 		me:        me,
 		headers:   r.Header,
 		Websocket: t,
 	}
 
+	defer trackConnection(&conn)()
+
 	if !conn.init() {
 		return
 	}
@@ -134,8 +233,10 @@ func (t Websocket) Do(w http.ResponseWriter, r *http.Request, exec graphql.Graph
 func (c *wsConnection) handlePossibleError(err error, isReadError bool) {
 	if c.ErrorFunc != nil && err != nil {
 		c.ErrorFunc(c.ctx, WebsocketError{
-			Err:         err,
-			IsReadError: isReadError,
+			Err:              err,
+			IsReadError:      isReadError,
+			IsReadLimitError: isReadError && errors.Is(err, websocket.ErrReadLimit),
+			IsWriteError:     !isReadError,
 		})
 	}
 }
@@ -173,7 +274,12 @@ func (c *wsConnection) init() bool {
 
 	if err != nil {
 		if err == errReadTimeout {
-			c.close(websocket.CloseProtocolError, "connection initialisation timeout")
+			c.close(c.initTimeoutCloseCode(), c.initTimeoutCloseReason())
+			return false
+		}
+
+		if errors.Is(err, websocket.ErrReadLimit) {
+			c.closeOnReadLimit(err)
 			return false
 		}
 
@@ -200,13 +306,34 @@ func (c *wsConnection) init() bool {
 			var ctx context.Context
 			ctx, initAckPayload, err = c.InitFunc(c.ctx, c.initPayload)
 			if err != nil {
-				c.sendConnectionError("%s", err.Error())
+				c.sendInitError(err)
 				c.close(websocket.CloseNormalClosure, "terminated")
 				return false
 			}
 			c.ctx = ctx
 		}
 
+		if c.ConnectionAckFunc != nil {
+			ackValues, err := c.ConnectionAckFunc(c.ctx, c.initPayload)
+			if err != nil {
+				c.sendInitError(err)
+				c.close(websocket.CloseNormalClosure, "terminated")
+				return false
+			}
+			if len(ackValues) > 0 {
+				merged := make(InitPayload, len(ackValues))
+				if initAckPayload != nil {
+					for k, v := range *initAckPayload {
+						merged[k] = v
+					}
+				}
+				for k, v := range ackValues {
+					merged[k] = v
+				}
+				initAckPayload = &merged
+			}
+		}
+
 		if initAckPayload != nil {
 			initJsonAckPayload, err := json.Marshal(*initAckPayload)
 			if err != nil {
@@ -229,10 +356,48 @@ func (c *wsConnection) init() bool {
 	return true
 }
 
-func (c *wsConnection) write(msg *message) {
+func (c *wsConnection) initTimeoutCloseCode() int {
+	if c.InitTimeoutCloseCode != 0 {
+		return c.InitTimeoutCloseCode
+	}
+	return websocket.CloseProtocolError
+}
+
+func (c *wsConnection) initTimeoutCloseReason() string {
+	if c.InitTimeoutCloseReason != "" {
+		return c.InitTimeoutCloseReason
+	}
+	return "connection initialisation timeout"
+}
+
+func (c *wsConnection) write(msg *message) int64 {
 	c.mu.Lock()
-	c.handlePossibleError(c.me.Send(msg), false)
+	if msg.uncompressed {
+		c.conn.EnableWriteCompression(false)
+	}
+	c.setWriteDeadline()
+	n, err := c.me.Send(msg)
+	c.handlePossibleError(err, false)
+	if msg.t == dataMessageType && c.KeepAliveOnlyWhenIdle && c.keepAliveTicker != nil {
+		c.keepAliveTicker.Reset(c.KeepAlivePingInterval)
+	}
+	if msg.uncompressed {
+		c.conn.EnableWriteCompression(true)
+	}
 	c.mu.Unlock()
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		c.close(websocket.CloseGoingAway, "write timeout")
+	}
+	return int64(n)
+}
+
+// setWriteDeadline sets the deadline of the next write to WriteTimeout from now, c.mu must be held.
+func (c *wsConnection) setWriteDeadline() {
+	if c.WriteTimeout != 0 {
+		_ = c.conn.SetWriteDeadline(time.Now().Add(c.WriteTimeout))
+	}
 }
 
 func (c *wsConnection) run() {
@@ -286,6 +451,10 @@ func (c *wsConnection) run() {
 		start := graphql.Now()
 		m, err := c.me.NextMessage()
 		if err != nil {
+			if errors.Is(err, websocket.ErrReadLimit) {
+				c.closeOnReadLimit(err)
+				return
+			}
 			// If the connection got closed by us, don't report the error
 			if !errors.Is(err, net.ErrClosed) {
 				c.handlePossibleError(err, true)
@@ -296,13 +465,34 @@ func (c *wsConnection) run() {
 		switch m.t {
 		case startMessageType:
 			c.subscribe(start, &m)
-		case stopMessageType:
+		case stopMessageType, completeMessageType:
+			// Some graphql-ws clients send complete rather than stop. Either way the id may already
+			// have been completed by the server, in which case there is nothing left to do.
 			c.mu.Lock()
 			closer := c.active[m.id]
 			c.mu.Unlock()
 			if closer != nil {
 				closer()
 			}
+		case requestMessageType:
+			if !c.FlowControl {
+				c.sendConnectionError("unexpected message %s", m.t)
+				c.close(websocket.CloseProtocolError, "unexpected message")
+				return
+			}
+			n, err := requestedCredit(m.payload)
+			if err != nil {
+				c.sendConnectionError("invalid request: %s", err.Error())
+				c.close(websocket.CloseProtocolError, "invalid request")
+				return
+			}
+			// like stop, the subscription may already have been completed by the server
+			c.mu.Lock()
+			credit := c.credits[m.id]
+			c.mu.Unlock()
+			if credit != nil {
+				credit.add(n)
+			}
 		case connectionCloseMessageType:
 			c.close(websocket.CloseNormalClosure, "terminated")
 			return
@@ -366,6 +556,13 @@ func (c *wsConnection) ping(ctx context.Context) {
 	}
 }
 
+// closeOnReadLimit closes the connection of a client that sent a message larger than MaxMessageSize.
+func (c *wsConnection) closeOnReadLimit(err error) {
+	c.handlePossibleError(err, true)
+	c.sendConnectionError("message exceeds the maximum size of %d bytes", c.MaxMessageSize)
+	c.close(websocket.CloseMessageTooBig, "message too big")
+}
+
 func (c *wsConnection) closeOnCancel(ctx context.Context) {
 	<-ctx.Done()
 
@@ -376,7 +573,22 @@ func (c *wsConnection) closeOnCancel(ctx context.Context) {
 }
 
 func (c *wsConnection) subscribe(start time.Time, msg *message) {
-	ctx := graphql.StartOperationTrace(c.ctx)
+	if c.MaxSubscriptionsPerConnection > 0 {
+		c.mu.Lock()
+		running := c.running
+		c.mu.Unlock()
+		if running >= c.MaxSubscriptionsPerConnection {
+			c.sendError(msg.id, gqlerror.Errorf("too many subscriptions, at most %d can run at the same time", c.MaxSubscriptionsPerConnection))
+			// in graphql-transport-ws the error message already ends the operation
+			if c.conn.Subprotocol() != graphqltransportwsSubprotocol {
+				c.complete(msg.id)
+			}
+			return
+		}
+	}
+
+	ctx := withConnectionContext(c.ctx, c.ctx)
+	ctx = graphql.StartOperationTrace(ctx)
 	var params *graphql.RawParams
 	if err := jsonDecode(bytes.NewReader(msg.payload), &params); err != nil {
 		c.sendError(msg.id, &gqlerror.Error{Message: "invalid json"})
@@ -398,7 +610,7 @@ func (c *wsConnection) subscribe(start time.Time, msg *message) {
 		case errcode.KindProtocol:
 			c.sendError(msg.id, resp.Errors...)
 		default:
-			c.sendResponse(msg.id, &graphql.Response{Errors: err})
+			c.sendResponse(msg.id, &graphql.Response{Errors: err}, false)
 		}
 
 		c.complete(msg.id)
@@ -411,14 +623,54 @@ func (c *wsConnection) subscribe(start time.Time, msg *message) {
 		ctx = withInitPayload(ctx, c.initPayload)
 	}
 
+	if c.OnOperation != nil {
+		if err := c.OnOperation(ctx, rc); err != nil {
+			resp := c.exec.DispatchError(ctx, gqlerror.List{gqlerror.WrapIfUnwrapped(err)})
+			c.sendError(msg.id, resp.Errors...)
+			c.complete(msg.id)
+			return
+		}
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
+	var credit *subscriptionCredit
+	if c.FlowControl {
+		credit = newSubscriptionCredit()
+	}
 	c.mu.Lock()
+	if c.draining {
+		c.mu.Unlock()
+		cancel()
+		c.sendError(msg.id, gqlerror.Errorf("server is shutting down"))
+		c.complete(msg.id)
+		return
+	}
 	c.active[msg.id] = cancel
+	if credit != nil {
+		c.credits[msg.id] = credit
+	}
+	c.subscriptions.Add(1)
+	c.running++
 	c.mu.Unlock()
 
 	go func() {
+		defer c.subscriptions.Done()
 		ctx = withSubscriptionErrorContext(ctx)
+		ctx = withCompletionReasonContext(ctx)
+		var written int64
 		defer func() {
+			// forget the operation before completing it, so clients can start another as soon as
+			// they are told it has finished
+			c.mu.Lock()
+			delete(c.active, msg.id)
+			delete(c.credits, msg.id)
+			c.running--
+			draining := c.draining
+			c.mu.Unlock()
+			if draining && completionPayload(ctx) == nil {
+				SetCompletionReason(ctx, CompletionReasonShutdown)
+			}
+
 			if r := recover(); r != nil {
 				err := rc.Recover(ctx, r)
 				var gqlerr *gqlerror.Error
@@ -428,50 +680,123 @@ func (c *wsConnection) subscribe(start time.Time, msg *message) {
 						gqlerr.Message = err.Error()
 					}
 				}
-				c.sendError(msg.id, gqlerr)
+				written += c.sendError(msg.id, gqlerr)
 			}
 			if errs := getSubscriptionError(ctx); len(errs) != 0 {
-				c.sendError(msg.id, errs...)
+				written += c.sendError(msg.id, errs...)
 			} else {
-				c.complete(msg.id)
+				written += c.write(&message{id: msg.id, t: completeMessageType, payload: completionPayload(ctx)})
 			}
-			c.mu.Lock()
-			delete(c.active, msg.id)
-			c.mu.Unlock()
+			reportBytesWritten(ctx, written)
 			cancel()
 		}()
 
-		responses, ctx := c.exec.DispatchOperation(ctx, rc)
-		for {
+		// Each subscription is written from this goroutine only, and write blocks until the
+		// message has been handed to the connection, so messages for a single id are always
+		// delivered in the order the resolver produced them. Responses read ahead by
+		// bufferResponses pass through a channel in the order they were read, so buffering does not
+		// reorder them either. Writes from other subscriptions may be interleaved between them.
+		//
+		// The operation context is done once its last response has been produced, so responses read
+		// ahead of the writer are buffered until the subscription context is done instead.
+		responses, execCtx := c.exec.DispatchOperation(ctx, rc)
+		next := func() (*graphql.Response, bool) {
+			ctx, uncompressed := withSkipCompressionContext(execCtx)
 			response := responses(ctx)
+			return response, uncompressed()
+		}
+		if credit != nil {
+			// the response is read before credit is taken, so the end of the subscription is seen
+			// and completed without waiting for the client to request more.
+			unlimited := next
+			next = func() (*graphql.Response, bool) {
+				response, uncompressed := unlimited()
+				if response == nil || !credit.take(execCtx) {
+					return nil, false
+				}
+				return response, uncompressed
+			}
+		} else if c.SubscriptionBufferSize > 0 {
+			next = bufferResponses(ctx, next, c.SubscriptionBufferSize)
+		}
+		for {
+			response, uncompressed := next()
 			if response == nil {
 				break
 			}
 
-			c.sendResponse(msg.id, response)
+			written += c.sendResponse(msg.id, response, uncompressed)
 		}
 
 		// complete and context cancel comes from the defer
 	}()
 }
 
-func (c *wsConnection) sendResponse(id string, response *graphql.Response) {
+// bufferResponses reads the responses of next from another goroutine, holding up to size of them
+// until they are taken. Panics are raised again by the returned func, and the responses left in the
+// buffer are dropped once ctx is done.
+func bufferResponses(ctx context.Context, next func() (*graphql.Response, bool), size int) func() (*graphql.Response, bool) {
+	type buffered struct {
+		response     *graphql.Response
+		uncompressed bool
+		panicked     any
+	}
+
+	ch := make(chan buffered, size)
+	go func() {
+		defer close(ch)
+		defer func() {
+			if r := recover(); r != nil {
+				select {
+				case ch <- buffered{panicked: r}:
+				case <-ctx.Done():
+				}
+			}
+		}()
+		for {
+			response, uncompressed := next()
+			if response == nil {
+				return
+			}
+			select {
+			case ch <- buffered{response: response, uncompressed: uncompressed}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return func() (*graphql.Response, bool) {
+		select {
+		case b := <-ch:
+			if b.panicked != nil {
+				panic(b.panicked)
+			}
+			return b.response, b.uncompressed
+		case <-ctx.Done():
+			return nil, false
+		}
+	}
+}
+
+func (c *wsConnection) sendResponse(id string, response *graphql.Response, uncompressed bool) int64 {
 	b, err := json.Marshal(response)
 	if err != nil {
 		panic(err)
 	}
-	c.write(&message{
-		payload: b,
-		id:      id,
-		t:       dataMessageType,
+	return c.write(&message{
+		payload:      b,
+		id:           id,
+		t:            dataMessageType,
+		uncompressed: uncompressed,
 	})
 }
 
-func (c *wsConnection) complete(id string) {
-	c.write(&message{id: id, t: completeMessageType})
+func (c *wsConnection) complete(id string) int64 {
+	return c.write(&message{id: id, t: completeMessageType})
 }
 
-func (c *wsConnection) sendError(id string, errors ...*gqlerror.Error) {
+func (c *wsConnection) sendError(id string, errors ...*gqlerror.Error) int64 {
 	errs := make([]error, len(errors))
 	for i, err := range errors {
 		errs[i] = err
@@ -480,7 +805,7 @@ func (c *wsConnection) sendError(id string, errors ...*gqlerror.Error) {
 	if err != nil {
 		panic(err)
 	}
-	c.write(&message{t: errorMessageType, id: id, payload: b})
+	return c.write(&message{t: errorMessageType, id: id, payload: b})
 }
 
 func (c *wsConnection) sendConnectionError(format string, args ...any) {
@@ -492,12 +817,30 @@ func (c *wsConnection) sendConnectionError(format string, args ...any) {
 	c.write(&message{t: connectionErrorMessageType, payload: b})
 }
 
+// sendInitError rejects the connection_init message, with the payload of err when it is a
+// WebsocketConnectionError.
+func (c *wsConnection) sendInitError(err error) {
+	var connErr WebsocketConnectionError
+	if !errors.As(err, &connErr) {
+		c.sendConnectionError("%s", err.Error())
+		return
+	}
+
+	b, err := json.Marshal(connErr.ConnectionErrorPayload())
+	if err != nil {
+		panic(err)
+	}
+
+	c.write(&message{t: connectionErrorMessageType, payload: b})
+}
+
 func (c *wsConnection) close(closeCode int, message string) {
 	c.mu.Lock()
 	if c.closed {
 		c.mu.Unlock()
 		return
 	}
+	c.setWriteDeadline()
 	_ = c.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(closeCode, message))
 	for _, closer := range c.active {
 		closer()
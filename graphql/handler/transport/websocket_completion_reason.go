@@ -0,0 +1,57 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+)
+
+// Reasons for SetCompletionReason, any other string can be sent as well.
+const (
+	// CompletionReasonEnd is for subscriptions whose resolver has nothing more to send.
+	CompletionReasonEnd = "end"
+	// CompletionReasonShutdown is for subscriptions ended by the server shutting down.
+	CompletionReasonShutdown = "shutdown"
+	// CompletionReasonError is for subscriptions ended by an error of their resolver.
+	CompletionReasonError = "error"
+)
+
+// A private key for context that only this package can access. This is important
+// to prevent collisions between different context uses
+var wsCompletionReasonCtxKey = &wsCompletionReasonContextKey{"completion-reason"}
+
+type wsCompletionReasonContextKey struct {
+	name string
+}
+
+// SetCompletionReason sets why the subscription of ctx completed, for a resolver or middleware ending
+// it. graphql-transport-ws clients get it in the payload of the complete message, eg.
+// {"id":"1","type":"complete","payload":{"reason":"shutdown"}}, while the complete message of other
+// clients, or of subscriptions without a reason, has no payload.
+func SetCompletionReason(ctx context.Context, reason string) {
+	if r, ok := ctx.Value(wsCompletionReasonCtxKey).(*atomic.Pointer[string]); ok {
+		r.Store(&reason)
+	}
+}
+
+func withCompletionReasonContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, wsCompletionReasonCtxKey, &atomic.Pointer[string]{})
+}
+
+// completionPayload returns the payload of the complete message of the subscription of ctx, or nil
+// when no reason was set.
+func completionPayload(ctx context.Context) json.RawMessage {
+	r, ok := ctx.Value(wsCompletionReasonCtxKey).(*atomic.Pointer[string])
+	if !ok {
+		return nil
+	}
+	reason := r.Load()
+	if reason == nil {
+		return nil
+	}
+	b, err := json.Marshal(map[string]string{"reason": *reason})
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
@@ -0,0 +1,32 @@
+package transport
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+var wsSkipCompressionCtxKey = &wsSkipCompressionContextKey{"skip-compression"}
+
+type wsSkipCompressionContextKey struct {
+	name string
+}
+
+// SkipNextMessageCompression sends the websocket message of the response being resolved without
+// compression, eg. when its payload is already compressed and deflating it again only costs CPU. It has
+// no effect unless permessage-deflate was negotiated through Websocket.Upgrader.EnableCompression, or
+// outside of a websocket operation.
+//
+// Call it from a resolver of the response, such as a resolver of a subscription payload. The mark
+// belongs to that response only, other messages of the connection are still compressed.
+func SkipNextMessageCompression(ctx context.Context) {
+	if skip, ok := ctx.Value(wsSkipCompressionCtxKey).(*atomic.Bool); ok {
+		skip.Store(true)
+	}
+}
+
+// withSkipCompressionContext returns a copy of ctx to resolve a single response with, and a func
+// reporting whether its message should be sent uncompressed.
+func withSkipCompressionContext(ctx context.Context) (context.Context, func() bool) {
+	skip := &atomic.Bool{}
+	return context.WithValue(ctx, wsSkipCompressionCtxKey, skip), skip.Load
+}
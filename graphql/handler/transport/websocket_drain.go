@@ -0,0 +1,93 @@
+package transport
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gorilla/websocket"
+)
+
+// websocketConnections are the connections served by the Websocket transports, so they can be
+// drained by DrainWebsockets.
+var websocketConnections = struct {
+	sync.Mutex
+	conns map[*wsConnection]struct{}
+}{conns: map[*wsConnection]struct{}{}}
+
+// trackConnection adds c to the connections drained by DrainWebsockets, until the returned func is
+// called.
+func trackConnection(c *wsConnection) func() {
+	websocketConnections.Lock()
+	websocketConnections.conns[c] = struct{}{}
+	websocketConnections.Unlock()
+
+	return func() {
+		websocketConnections.Lock()
+		delete(websocketConnections.conns, c)
+		websocketConnections.Unlock()
+	}
+}
+
+// DrainWebsockets ends the connections of all the Websocket transports, eg. when the server shuts
+// down, so clients can cleanly reconnect to another one. http.Server.Shutdown doesn't wait for
+// websocket connections, as they are hijacked, so call it once Shutdown has stopped accepting new
+// ones.
+//
+// Active subscriptions are completed, with the CompletionReasonShutdown reason for
+// graphql-transport-ws clients, and once they are all completed each connection is closed with 1000
+// (normal closure). Connections whose subscriptions aren't completed when ctx is done are closed
+// right away, and ctx.Err() is returned if there were any.
+func DrainWebsockets(ctx context.Context) error {
+	websocketConnections.Lock()
+	conns := make([]*wsConnection, 0, len(websocketConnections.conns))
+	for c := range websocketConnections.conns {
+		conns = append(conns, c)
+	}
+	websocketConnections.Unlock()
+
+	var wg sync.WaitGroup
+	var incomplete atomic.Bool
+	for _, c := range conns {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if !c.drain(ctx) {
+				incomplete.Store(true)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if incomplete.Load() {
+		return ctx.Err()
+	}
+	return nil
+}
+
+// drain completes the subscriptions of c, and closes it once they are completed or ctx is done. It
+// reports whether the subscriptions were completed before ctx was done.
+func (c *wsConnection) drain(ctx context.Context) bool {
+	c.mu.Lock()
+	c.draining = true
+	for _, cancel := range c.active {
+		cancel()
+	}
+	c.mu.Unlock()
+
+	completed := make(chan struct{})
+	go func() {
+		c.subscriptions.Wait()
+		close(completed)
+	}()
+
+	var ok bool
+	select {
+	case <-completed:
+		ok = true
+	case <-ctx.Done():
+	}
+
+	c.close(websocket.CloseNormalClosure, "server shutting down")
+	return ok
+}
@@ -0,0 +1,62 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+)
+
+// subscriptionCredit counts the responses a flow-controlled client has requested and not received yet.
+type subscriptionCredit struct {
+	mu    sync.Mutex
+	n     int
+	added chan struct{}
+}
+
+func newSubscriptionCredit() *subscriptionCredit {
+	return &subscriptionCredit{added: make(chan struct{}, 1)}
+}
+
+// add grants n more responses.
+func (s *subscriptionCredit) add(n int) {
+	s.mu.Lock()
+	s.n += n
+	s.mu.Unlock()
+
+	select {
+	case s.added <- struct{}{}:
+	default:
+	}
+}
+
+// take waits until a response has been requested and uses it up, it returns false if ctx is done first.
+func (s *subscriptionCredit) take(ctx context.Context) bool {
+	for {
+		s.mu.Lock()
+		if s.n > 0 {
+			s.n--
+			s.mu.Unlock()
+			return true
+		}
+		s.mu.Unlock()
+
+		select {
+		case <-s.added:
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// requestedCredit returns the number of responses requested by the payload of a request message,
+// eg. {"n":10}.
+func requestedCredit(payload json.RawMessage) (int, error) {
+	var request struct {
+		N int `json:"n"`
+	}
+	if err := json.Unmarshal(payload, &request); err != nil || request.N <= 0 {
+		return 0, errors.New("request payload must have a positive n")
+	}
+	return request.N, nil
+}
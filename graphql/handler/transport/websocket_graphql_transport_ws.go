@@ -19,6 +19,7 @@ const (
 	graphqltransportwsCompleteMsg       = graphqltransportwsMessageType("complete")
 	graphqltransportwsPingMsg           = graphqltransportwsMessageType("ping")
 	graphqltransportwsPongMsg           = graphqltransportwsMessageType("pong")
+	graphqltransportwsRequestMsg        = graphqltransportwsMessageType("request")
 )
 
 var allGraphqltransportwsMessageTypes = []graphqltransportwsMessageType{
@@ -30,11 +31,14 @@ var allGraphqltransportwsMessageTypes = []graphqltransportwsMessageType{
 	graphqltransportwsCompleteMsg,
 	graphqltransportwsPingMsg,
 	graphqltransportwsPongMsg,
+	graphqltransportwsRequestMsg,
 }
 
 type (
 	graphqltransportwsMessageExchanger struct {
-		c *websocket.Conn
+		c              *websocket.Conn
+		codec          WebsocketMessageCodec
+		maxMessageSize int64
 	}
 
 	graphqltransportwsMessage struct {
@@ -48,30 +52,25 @@ type (
 )
 
 func (me graphqltransportwsMessageExchanger) NextMessage() (message, error) {
-	_, r, err := me.c.NextReader()
-	if err != nil {
-		return message{}, handleNextReaderError(err)
-	}
-
 	var graphqltransportwsMessage graphqltransportwsMessage
-	if err := jsonDecode(r, &graphqltransportwsMessage); err != nil {
-		return message{}, errInvalidMsg
+	if err := readMessage(me.c, me.codec, me.maxMessageSize, &graphqltransportwsMessage); err != nil {
+		return message{}, err
 	}
 
 	return graphqltransportwsMessage.toMessage()
 }
 
-func (me graphqltransportwsMessageExchanger) Send(m *message) error {
+func (me graphqltransportwsMessageExchanger) Send(m *message) (int, error) {
 	msg := &graphqltransportwsMessage{}
 	if err := msg.fromMessage(m); err != nil {
-		return err
+		return 0, err
 	}
 
 	if msg.noOp {
-		return nil
+		return 0, nil
 	}
 
-	return me.c.WriteJSON(msg)
+	return writeMessage(me.c, me.codec, msg)
 }
 
 func (t *graphqltransportwsMessageType) UnmarshalText(text []byte) (err error) {
@@ -111,6 +110,8 @@ func (m graphqltransportwsMessage) toMessage() (message, error) {
 		t = pingMessageType
 	case graphqltransportwsPongMsg:
 		t = pongMessageType
+	case graphqltransportwsRequestMsg:
+		t = requestMessageType
 	}
 
 	return message{
@@ -147,3 +148,7 @@ func (m *graphqltransportwsMessage) fromMessage(msg *message) (err error) {
 
 	return err
 }
+
+func (m *graphqltransportwsMessage) fields() (typ, id string, payload json.RawMessage) {
+	return string(m.Type), m.ID, m.Payload
+}
@@ -21,6 +21,7 @@ const (
 	graphqlwsErrorMsg               = graphqlwsMessageType("error")
 	graphqlwsCompleteMsg            = graphqlwsMessageType("complete")
 	graphqlwsConnectionKeepAliveMsg = graphqlwsMessageType("ka")
+	graphqlwsRequestMsg             = graphqlwsMessageType("request")
 )
 
 var allGraphqlwsMessageTypes = []graphqlwsMessageType{
@@ -34,11 +35,14 @@ var allGraphqlwsMessageTypes = []graphqlwsMessageType{
 	graphqlwsErrorMsg,
 	graphqlwsCompleteMsg,
 	graphqlwsConnectionKeepAliveMsg,
+	graphqlwsRequestMsg,
 }
 
 type (
 	graphqlwsMessageExchanger struct {
-		c *websocket.Conn
+		c              *websocket.Conn
+		codec          WebsocketMessageCodec
+		maxMessageSize int64
 	}
 
 	graphqlwsMessage struct {
@@ -52,30 +56,25 @@ type (
 )
 
 func (me graphqlwsMessageExchanger) NextMessage() (message, error) {
-	_, r, err := me.c.NextReader()
-	if err != nil {
-		return message{}, handleNextReaderError(err)
-	}
-
 	var graphqlwsMessage graphqlwsMessage
-	if err := jsonDecode(r, &graphqlwsMessage); err != nil {
-		return message{}, errInvalidMsg
+	if err := readMessage(me.c, me.codec, me.maxMessageSize, &graphqlwsMessage); err != nil {
+		return message{}, err
 	}
 
 	return graphqlwsMessage.toMessage()
 }
 
-func (me graphqlwsMessageExchanger) Send(m *message) error {
+func (me graphqlwsMessageExchanger) Send(m *message) (int, error) {
 	msg := &graphqlwsMessage{}
 	if err := msg.fromMessage(m); err != nil {
-		return err
+		return 0, err
 	}
 
 	if msg.noOp {
-		return nil
+		return 0, nil
 	}
 
-	return me.c.WriteJSON(msg)
+	return writeMessage(me.c, me.codec, msg)
 }
 
 func (t *graphqlwsMessageType) UnmarshalText(text []byte) (err error) {
@@ -125,6 +124,8 @@ func (m graphqlwsMessage) toMessage() (message, error) {
 		t = completeMessageType
 	case graphqlwsConnectionKeepAliveMsg:
 		t = keepAliveMessageType
+	case graphqlwsRequestMsg:
+		t = requestMessageType
 	}
 
 	return message{
@@ -159,6 +160,8 @@ func (m *graphqlwsMessage) fromMessage(msg *message) (err error) {
 		m.Type = graphqlwsDataMsg
 	case completeMessageType:
 		m.Type = graphqlwsCompleteMsg
+		// completion reasons are only sent to graphql-transport-ws clients
+		m.Payload = nil
 	case errorMessageType:
 		m.Type = graphqlwsErrorMsg
 	case pingMessageType:
@@ -169,3 +172,7 @@ func (m *graphqlwsMessage) fromMessage(msg *message) (err error) {
 
 	return err
 }
+
+func (m *graphqlwsMessage) fields() (typ, id string, payload json.RawMessage) {
+	return string(m.Type), m.ID, m.Payload
+}
@@ -1,11 +1,18 @@
 package transport
 
-import "context"
+import (
+	"context"
+	"net/http"
+)
 
 type key string
 
 const (
-	initpayload key = "ws_initpayload_context"
+	initpayload      key = "ws_initpayload_context"
+	connectionctx    key = "ws_connection_context"
+	bytesWrittenFunc key = "bytes_written_func"
+	subprotocolctx   key = "ws_subprotocol_context"
+	initrequestctx   key = "ws_init_request_context"
 )
 
 // InitPayload is a structure that is parsed from the websocket init message payload. TO use
@@ -55,3 +62,44 @@ func GetInitPayload(ctx context.Context) InitPayload {
 
 	return payload
 }
+
+func withConnectionContext(ctx, connCtx context.Context) context.Context {
+	return context.WithValue(ctx, connectionctx, connCtx)
+}
+
+// ConnectionContext gets the context of the websocket connection an operation runs on, as returned
+// by the InitFunc. Operation contexts are derived from it, but it lives for the whole connection
+// and isn't affected by the values middlewares add to a single operation. It returns nil outside
+// of websocket operations.
+func ConnectionContext(ctx context.Context) context.Context {
+	connCtx, ok := ctx.Value(connectionctx).(context.Context)
+	if !ok {
+		return nil
+	}
+
+	return connCtx
+}
+
+func withSubprotocol(ctx context.Context, subprotocol string) context.Context {
+	return context.WithValue(ctx, subprotocolctx, subprotocol)
+}
+
+// GetSubprotocol gets the websocket subprotocol an operation arrived over, either "graphql-ws" or
+// "graphql-transport-ws". Connections that didn't negotiate one use "graphql-ws". It returns an
+// empty string outside of websocket operations.
+func GetSubprotocol(ctx context.Context) string {
+	subprotocol, _ := ctx.Value(subprotocolctx).(string)
+	return subprotocol
+}
+
+func withInitRequest(ctx context.Context, r *http.Request) context.Context {
+	return context.WithValue(ctx, initrequestctx, r)
+}
+
+// GetInitRequest gets the HTTP request a websocket connection was upgraded from, eg. to read its
+// Host header from an operation. It must not be modified, and its body has already been read. It
+// returns nil outside of websocket connections.
+func GetInitRequest(ctx context.Context) *http.Request {
+	r, _ := ctx.Value(initrequestctx).(*http.Request)
+	return r
+}
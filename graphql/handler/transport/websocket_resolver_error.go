@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
 )
 
 // A private key for context that only this package can access. This is important
@@ -56,6 +58,7 @@ func AddSubscriptionError(ctx context.Context, err *gqlerror.Error) {
 }
 
 func withSubscriptionErrorContext(ctx context.Context) context.Context {
+	ctx = graphql.WithSubscriptionErrors(ctx)
 	return context.WithValue(ctx, wsSubscriptionErrorCtxKey, &subscriptionError{})
 }
 
@@ -65,5 +68,5 @@ func getSubscriptionErrorStruct(ctx context.Context) *subscriptionError {
 }
 
 func getSubscriptionError(ctx context.Context) []*gqlerror.Error {
-	return getSubscriptionErrorStruct(ctx).errs
+	return append(getSubscriptionErrorStruct(ctx).errs, graphql.GetSubscriptionErrors(ctx)...)
 }
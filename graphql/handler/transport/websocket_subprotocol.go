@@ -1,8 +1,10 @@
 package transport
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
+	"io"
 
 	"github.com/gorilla/websocket"
 )
@@ -20,6 +22,7 @@ const (
 	errorMessageType
 	pingMessageType
 	pongMessageType
+	requestMessageType
 )
 
 var (
@@ -35,13 +38,20 @@ var (
 type (
 	messageType int
 	message     struct {
-		payload json.RawMessage
-		id      string
-		t       messageType
+		payload      json.RawMessage
+		id           string
+		t            messageType
+		uncompressed bool
+	}
+	// wireMessage is a message of a subprotocol, as written to clients.
+	wireMessage interface {
+		// fields returns the type, id and payload of the message.
+		fields() (typ, id string, payload json.RawMessage)
 	}
 	messageExchanger interface {
 		NextMessage() (message, error)
-		Send(m *message) error
+		// Send writes the message and returns the number of bytes written.
+		Send(m *message) (int, error)
 	}
 )
 
@@ -74,6 +84,8 @@ func (t messageType) String() string {
 		text = "ping"
 	case pongMessageType:
 		text = "pong"
+	case requestMessageType:
+		text = "request"
 	}
 	return text
 }
@@ -105,6 +117,134 @@ func (t *Websocket) injectGraphQLWSSubprotocols() {
 	}
 }
 
+// readMessage reads the next message of c into v. Binary messages are decoded with codec when it is
+// set, and read as JSON otherwise. When maxSize is set, reading more than maxSize bytes of the message
+// fails with websocket.ErrReadLimit.
+func readMessage(c *websocket.Conn, codec WebsocketMessageCodec, maxSize int64, v any) error {
+	t, r, err := c.NextReader()
+	if err != nil {
+		return handleNextReaderError(err)
+	}
+	if maxSize > 0 {
+		r = &messageLimitReader{r: r, n: maxSize}
+	}
+
+	if t == websocket.BinaryMessage && codec != nil {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return handleNextReaderError(err)
+		}
+		var decoded any
+		if err := codec.Unmarshal(data, &decoded); err != nil {
+			return errInvalidMsg
+		}
+		// the subprotocols read their messages, and the executor their payloads, from JSON
+		if data, err = json.Marshal(decoded); err != nil {
+			return errInvalidMsg
+		}
+		r = bytes.NewReader(data)
+	}
+
+	if err := jsonDecode(r, v); err != nil {
+		if errors.Is(err, websocket.ErrReadLimit) {
+			return err
+		}
+		return errInvalidMsg
+	}
+	return nil
+}
+
+// writeMessage writes m to c as JSON in a text message, or in a binary message encoded with codec when
+// it is set. It returns the size of the message.
+func writeMessage(c *websocket.Conn, codec WebsocketMessageCodec, m wireMessage) (int, error) {
+	if codec == nil {
+		b, err := json.Marshal(m)
+		if err != nil {
+			return 0, err
+		}
+		return written(b, c.WriteMessage(websocket.TextMessage, b))
+	}
+
+	typ, id, payload := m.fields()
+	v := map[string]any{"type": typ}
+	if id != "" {
+		v["id"] = id
+	}
+	if len(payload) != 0 {
+		// the payload is decoded, so the codec encodes it like the rest of the message
+		p, err := decodeJSONValue(payload)
+		if err != nil {
+			return 0, err
+		}
+		v["payload"] = p
+	}
+
+	b, err := codec.Marshal(v)
+	if err != nil {
+		return 0, err
+	}
+	return written(b, c.WriteMessage(websocket.BinaryMessage, b))
+}
+
+// decodeJSONValue decodes data to the values given to a WebsocketMessageCodec, keeping integers as
+// int64 instead of float64.
+func decodeJSONValue(data []byte) (any, error) {
+	var v any
+	if err := jsonDecode(bytes.NewReader(data), &v); err != nil {
+		return nil, err
+	}
+	return numbersToValues(v), nil
+}
+
+func numbersToValues(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			v[k] = numbersToValues(e)
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = numbersToValues(e)
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	}
+	return v
+}
+
+// written returns how many bytes of b a message write wrote, which is none when it failed.
+func written(b []byte, err error) (int, error) {
+	if err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+// messageLimitReader reads at most one byte more than n from r, and fails once it has.
+type messageLimitReader struct {
+	r io.Reader
+	n int64
+}
+
+func (l *messageLimitReader) Read(p []byte) (int, error) {
+	if l.n < 0 {
+		return 0, websocket.ErrReadLimit
+	}
+	if int64(len(p)) > l.n+1 {
+		p = p[:l.n+1]
+	}
+	n, err := l.r.Read(p)
+	l.n -= int64(n)
+	if l.n < 0 {
+		return n, websocket.ErrReadLimit
+	}
+	return n, err
+}
+
 func handleNextReaderError(err error) error {
 	// TODO: should we consider all closure scenarios here for the ws connection?
 	// for now we only list the error codes from the previous implementation
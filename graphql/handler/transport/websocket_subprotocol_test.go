@@ -0,0 +1,60 @@
+package transport
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteMessage(t *testing.T) {
+	type result struct {
+		n   int
+		err error
+	}
+	results := make(chan result, 2)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		n, err := writeMessage(c, nil, &graphqlwsMessage{Type: graphqlwsConnectionKeepAliveMsg})
+		results <- result{n, err}
+
+		c.Close()
+		n, err = writeMessage(c, nil, &graphqlwsMessage{Type: graphqlwsConnectionKeepAliveMsg})
+		results <- result{n, err}
+	}))
+	defer srv.Close()
+
+	c, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	require.NoError(t, err)
+	defer c.Close()
+
+	t.Run("reports the bytes written", func(t *testing.T) {
+		res := <-results
+		require.NoError(t, res.err)
+		require.Equal(t, len(`{"type":"ka"}`), res.n)
+	})
+
+	t.Run("reports no bytes when the write fails", func(t *testing.T) {
+		res := <-results
+		require.Error(t, res.err)
+		require.Zero(t, res.n)
+	})
+}
+
+func TestDecodeJSONValue(t *testing.T) {
+	v, err := decodeJSONValue([]byte(`{"data":{"id":9007199254740993,"ratio":0.5,"tags":["a",null,true]}}`))
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"data": map[string]any{
+			"id":    int64(9007199254740993),
+			"ratio": 0.5,
+			"tags":  []any{"a", nil, true},
+		},
+	}, v)
+}
@@ -1,12 +1,18 @@
 package transport_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -15,9 +21,11 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/vektah/gqlparser/v2"
 	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 
 	"github.com/99designs/gqlgen/client"
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/graphqltest"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/testserver"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
@@ -153,6 +161,1160 @@ func TestWebsocket(t *testing.T) {
 	})
 }
 
+func TestWebsocketStopUnknownSubscription(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(transport.Websocket{})
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	t.Run("graphql-ws ignores stop and complete for unknown ids", func(t *testing.T) {
+		c := wsConnect(srv.URL)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+		assert.Equal(t, connectionAckMsg, readOp(c).Type)
+		assert.Equal(t, connectionKeepAliveMsg, readOp(c).Type)
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: stopMsg, ID: "unknown"}))
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: completeMsg, ID: "unknown"}))
+
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    startMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": "subscription { name }"}`),
+		}))
+
+		h.SendNextSubscriptionMessage()
+		msg := readOp(c)
+		require.Equal(t, dataMsg, msg.Type, string(msg.Payload))
+		require.Equal(t, "test_1", msg.ID)
+	})
+
+	t.Run("graphql-transport-ws ignores complete for unknown ids", func(t *testing.T) {
+		c := wsConnectWithSubprotocol(srv.URL, graphqltransportwsSubprotocol)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsConnectionInitMsg}))
+		assert.Equal(t, graphqltransportwsConnectionAckMsg, readOp(c).Type)
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsCompleteMsg, ID: "unknown"}))
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsPingMsg}))
+
+		assert.Equal(t, graphqltransportwsPongMsg, readOp(c).Type)
+	})
+}
+
+func TestWebsocketSubscriptionOrdering(t *testing.T) {
+	const (
+		subscriptions = 20
+		messages      = 200
+	)
+
+	es := &graphql.ExecutableSchemaMock{
+		ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
+			// mimic a resolver sending to a channel as fast as it can
+			ch := make(chan int)
+			go func() {
+				defer close(ch)
+				for i := 0; i < messages; i++ {
+					select {
+					case ch <- i:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}()
+			return func(ctx context.Context) *graphql.Response {
+				i, ok := <-ch
+				if !ok {
+					return nil
+				}
+				b, _ := json.Marshal(map[string]int{"count": i})
+				return &graphql.Response{Data: b}
+			}
+		},
+		SchemaFunc: func() *ast.Schema {
+			return gqlparser.MustLoadSchema(&ast.Source{Input: `
+				type Query { empty: String }
+				type Subscription { count: Int! }
+			`})
+		},
+	}
+	h := handler.New(es)
+	h.AddTransport(transport.Websocket{})
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	c := wsConnectWithSubprotocol(srv.URL, graphqltransportwsSubprotocol)
+	defer c.Close()
+
+	require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsConnectionInitMsg}))
+	assert.Equal(t, graphqltransportwsConnectionAckMsg, readOp(c).Type)
+
+	for i := 0; i < subscriptions; i++ {
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    graphqltransportwsSubscribeMsg,
+			ID:      fmt.Sprintf("sub_%d", i),
+			Payload: json.RawMessage(`{"query": "subscription { count }"}`),
+		}))
+	}
+
+	next := map[string]int{}
+	completed := 0
+	for completed < subscriptions {
+		msg := readOp(c)
+		switch msg.Type {
+		case graphqltransportwsNextMsg:
+			var payload struct {
+				Data struct {
+					Count int `json:"count"`
+				} `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(msg.Payload, &payload))
+			require.Equal(t, next[msg.ID], payload.Data.Count, "out of order message for %s", msg.ID)
+			next[msg.ID]++
+		case graphqltransportwsCompleteMsg:
+			require.Equal(t, messages, next[msg.ID], "%s completed early", msg.ID)
+			completed++
+		default:
+			require.Failf(t, "unexpected message", "%s: %s", msg.Type, string(msg.Payload))
+		}
+	}
+}
+
+func TestWebsocketSubscriptionBufferSize(t *testing.T) {
+	const burst = 5
+
+	// produced counts the responses of a bursty resolver read while the first one is being written
+	produced := func(t *testing.T, bufferSize int, expected int32) {
+		var count atomic.Int32
+		release := make(chan struct{})
+		h := handler.New(graphqltest.NewMockSchema(`
+			type Query { empty: String }
+			type Subscription { count: Int! }
+		`, func(ctx context.Context) graphql.ResponseHandler {
+			return func(ctx context.Context) *graphql.Response {
+				i := count.Add(1)
+				if i > burst {
+					return nil
+				}
+				b, _ := json.Marshal(map[string]int32{"count": i})
+				return &graphql.Response{
+					Data:   b,
+					Errors: gqlerror.List{{Message: "slow", Extensions: map[string]any{"write": slowMarshaler(release)}}},
+				}
+			}
+		}))
+		h.AddTransport(transport.Websocket{SubscriptionBufferSize: bufferSize})
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		c := wsConnectWithSubprotocol(srv.URL, graphqltransportwsSubprotocol)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsConnectionInitMsg}))
+		assert.Equal(t, graphqltransportwsConnectionAckMsg, readOp(c).Type)
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    graphqltransportwsSubscribeMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": "subscription { count }"}`),
+		}))
+
+		require.Eventually(t, func() bool { return count.Load() >= expected }, time.Second, time.Millisecond)
+		time.Sleep(50 * time.Millisecond)
+		require.Equal(t, expected, count.Load())
+
+		close(release)
+		for i := 1; i <= burst; i++ {
+			msg := readOp(c)
+			require.Equal(t, graphqltransportwsNextMsg, msg.Type)
+			require.Contains(t, string(msg.Payload), fmt.Sprintf(`"data":{"count":%d}`, i))
+		}
+		assert.Equal(t, graphqltransportwsCompleteMsg, readOp(c).Type)
+	}
+
+	t.Run("unbuffered responses wait for the previous write", func(t *testing.T) {
+		produced(t, 0, 1)
+	})
+
+	t.Run("buffered responses are read ahead of the writer", func(t *testing.T) {
+		// one response is being written while the rest of the burst fills the buffer, and the end
+		// of the stream is read once there is room for it
+		produced(t, burst, burst+1)
+	})
+
+	t.Run("the buffer limits how far ahead responses are read", func(t *testing.T) {
+		produced(t, 2, 4)
+	})
+}
+
+func TestWebsocketFlowControl(t *testing.T) {
+	const total = 5
+
+	var count atomic.Int32
+	h := handler.New(graphqltest.NewMockSchema(`
+		type Query { empty: String }
+		type Subscription { count: Int! }
+	`, func(ctx context.Context) graphql.ResponseHandler {
+		return func(ctx context.Context) *graphql.Response {
+			i := count.Add(1)
+			if i > total {
+				return nil
+			}
+			b, _ := json.Marshal(map[string]int32{"count": i})
+			return &graphql.Response{Data: b}
+		}
+	}))
+	h.AddTransport(transport.Websocket{FlowControl: true})
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	// read asserts no more than one response past the requested ones has been read from the resolver
+	read := func(t *testing.T, expected int32) {
+		time.Sleep(50 * time.Millisecond)
+		require.Equal(t, expected, count.Load())
+	}
+
+	t.Run("responses are only sent once requested", func(t *testing.T) {
+		count.Store(0)
+		c := wsConnectWithSubprotocol(srv.URL, graphqltransportwsSubprotocol)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsConnectionInitMsg}))
+		assert.Equal(t, graphqltransportwsConnectionAckMsg, readOp(c).Type)
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    graphqltransportwsSubscribeMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": "subscription { count }"}`),
+		}))
+		read(t, 1)
+
+		for _, window := range []struct{ n, from, to int }{{2, 1, 2}, {3, 3, 5}} {
+			require.NoError(t, c.WriteJSON(&operationMessage{
+				Type:    graphqltransportwsRequestMsg,
+				ID:      "test_1",
+				Payload: json.RawMessage(fmt.Sprintf(`{"n": %d}`, window.n)),
+			}))
+			for i := window.from; i <= window.to; i++ {
+				msg := readOp(c)
+				require.Equal(t, graphqltransportwsNextMsg, msg.Type)
+				require.JSONEq(t, fmt.Sprintf(`{"data":{"count":%d}}`, i), string(msg.Payload))
+			}
+			read(t, int32(window.to+1))
+		}
+
+		// the subscription completes without a request for more responses
+		assert.Equal(t, graphqltransportwsCompleteMsg, readOp(c).Type)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		c := wsConnect(srv.URL)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+		assert.Equal(t, connectionAckMsg, readOp(c).Type)
+		assert.Equal(t, connectionKeepAliveMsg, readOp(c).Type)
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: requestMsg, ID: "test_1", Payload: json.RawMessage(`{"n": 0}`)}))
+
+		msg := readOp(c)
+		assert.Equal(t, connectionErrorMsg, msg.Type)
+		assert.JSONEq(t, `{"message":"invalid request: request payload must have a positive n"}`, string(msg.Payload))
+	})
+
+	t.Run("request without flow control", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.Websocket{})
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		c := wsConnect(srv.URL)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+		assert.Equal(t, connectionAckMsg, readOp(c).Type)
+		assert.Equal(t, connectionKeepAliveMsg, readOp(c).Type)
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: requestMsg, ID: "test_1", Payload: json.RawMessage(`{"n": 1}`)}))
+
+		msg := readOp(c)
+		assert.Equal(t, connectionErrorMsg, msg.Type)
+		assert.JSONEq(t, `{"message":"unexpected message request"}`, string(msg.Payload))
+	})
+}
+
+func TestWebsocketMaxMessageSize(t *testing.T) {
+	errs := make(chan transport.WebsocketError, 1)
+	h := testserver.New()
+	h.AddTransport(transport.Websocket{
+		MaxMessageSize: 256,
+		ErrorFunc: func(_ context.Context, err error) {
+			var wsErr transport.WebsocketError
+			if errors.As(err, &wsErr) && wsErr.IsReadLimitError {
+				errs <- wsErr
+			}
+		},
+	})
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	bigQuery := "subscription { name " + strings.Repeat(" ", 256) + "}"
+
+	assertReadLimitError := func(t *testing.T) {
+		select {
+		case err := <-errs:
+			assert.True(t, err.IsReadError)
+			assert.ErrorIs(t, err.Err, websocket.ErrReadLimit)
+		case <-time.After(time.Second):
+			assert.Fail(t, "the error handler was not called in time")
+		}
+	}
+
+	t.Run("messages under the limit are read", func(t *testing.T) {
+		c := wsConnect(srv.URL)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+		assert.Equal(t, connectionAckMsg, readOp(c).Type)
+		assert.Equal(t, connectionKeepAliveMsg, readOp(c).Type)
+
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    startMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": "subscription { name }"}`),
+		}))
+		h.SendNextSubscriptionMessage()
+		msg := readOp(c)
+		require.Equal(t, dataMsg, msg.Type, string(msg.Payload))
+		require.JSONEq(t, `{"data":{"name":"test"}}`, string(msg.Payload))
+	})
+
+	t.Run("larger messages close the connection", func(t *testing.T) {
+		c := wsConnect(srv.URL)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+		assert.Equal(t, connectionAckMsg, readOp(c).Type)
+		assert.Equal(t, connectionKeepAliveMsg, readOp(c).Type)
+
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    startMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": ` + strconv.Quote(bigQuery) + `}`),
+		}))
+		msg := readOp(c)
+		require.Equal(t, connectionErrorMsg, msg.Type)
+		require.JSONEq(t, `{"message":"message exceeds the maximum size of 256 bytes"}`, string(msg.Payload))
+
+		_, _, err := c.ReadMessage()
+		assert.True(t, websocket.IsCloseError(err, websocket.CloseMessageTooBig), err)
+		assertReadLimitError(t)
+	})
+
+	t.Run("larger messages close the connection with graphql-transport-ws", func(t *testing.T) {
+		c := wsConnectWithSubprotocol(srv.URL, graphqltransportwsSubprotocol)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    graphqltransportwsConnectionInitMsg,
+			Payload: json.RawMessage(`{"padding": ` + strconv.Quote(bigQuery) + `}`),
+		}))
+
+		_, _, err := c.ReadMessage()
+		assert.True(t, websocket.IsCloseError(err, websocket.CloseMessageTooBig), err)
+		assertReadLimitError(t)
+	})
+}
+
+func TestWebsocketWriteTimeout(t *testing.T) {
+	t.Run("closes the connection when a write times out", func(t *testing.T) {
+		errs := make(chan transport.WebsocketError, 1)
+		closed := make(chan int, 1)
+		h := testserver.New()
+		h.AddTransport(transport.Websocket{
+			// every write misses a deadline this short
+			WriteTimeout: time.Nanosecond,
+			ErrorFunc: func(_ context.Context, err error) {
+				var wsErr transport.WebsocketError
+				if errors.As(err, &wsErr) && wsErr.IsWriteError {
+					select {
+					case errs <- wsErr:
+					default:
+					}
+				}
+			},
+			CloseFunc: func(_ context.Context, closeCode int) {
+				closed <- closeCode
+			},
+		})
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		c := wsConnect(srv.URL)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+
+		select {
+		case err := <-errs:
+			assert.False(t, err.IsReadError)
+			var netErr net.Error
+			require.ErrorAs(t, err.Err, &netErr)
+			assert.True(t, netErr.Timeout())
+		case <-time.After(time.Second):
+			assert.Fail(t, "the error handler was not called in time")
+		}
+
+		select {
+		case closeCode := <-closed:
+			assert.Equal(t, websocket.CloseGoingAway, closeCode)
+		case <-time.After(time.Second):
+			assert.Fail(t, "the connection was not closed in time")
+		}
+
+		_, _, err := c.ReadMessage()
+		assert.Error(t, err)
+	})
+
+	t.Run("writes that finish in time are sent", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.Websocket{
+			WriteTimeout: time.Second,
+			ErrorFunc: func(_ context.Context, err error) {
+				var wsErr transport.WebsocketError
+				if errors.As(err, &wsErr) && wsErr.IsWriteError {
+					assert.Fail(t, "the error handler got called when it shouldn't have", "error: "+err.Error())
+				}
+			},
+		})
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		c := wsConnect(srv.URL)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+		assert.Equal(t, connectionAckMsg, readOp(c).Type)
+		assert.Equal(t, connectionKeepAliveMsg, readOp(c).Type)
+
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    startMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": "subscription { name }"}`),
+		}))
+		h.SendNextSubscriptionMessage()
+		msg := readOp(c)
+		require.Equal(t, dataMsg, msg.Type, string(msg.Payload))
+		require.JSONEq(t, `{"data":{"name":"test"}}`, string(msg.Payload))
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: stopMsg, ID: "test_1"}))
+		msg = readOp(c)
+		require.Equal(t, completeMsg, msg.Type, string(msg.Payload))
+	})
+}
+
+func TestWebsocketOnOperation(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(transport.Websocket{
+		OnOperation: func(ctx context.Context, oc *graphql.OperationContext) error {
+			if oc.Operation.Name == "Denied" {
+				return errors.New("operation denied")
+			}
+			return nil
+		},
+	})
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	t.Run("graphql-ws", func(t *testing.T) {
+		c := wsConnect(srv.URL)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+		assert.Equal(t, connectionAckMsg, readOp(c).Type)
+		assert.Equal(t, connectionKeepAliveMsg, readOp(c).Type)
+
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    startMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": "subscription Denied { name }"}`),
+		}))
+		msg := readOp(c)
+		require.Equal(t, errorMsg, msg.Type, string(msg.Payload))
+		require.Equal(t, "test_1", msg.ID)
+		require.JSONEq(t, `[{"message":"operation denied"}]`, string(msg.Payload))
+		msg = readOp(c)
+		require.Equal(t, completeMsg, msg.Type)
+		require.Equal(t, "test_1", msg.ID)
+
+		// the connection is still usable
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    startMsg,
+			ID:      "test_2",
+			Payload: json.RawMessage(`{"query": "subscription Allowed { name }"}`),
+		}))
+		h.SendNextSubscriptionMessage()
+		msg = readOp(c)
+		require.Equal(t, dataMsg, msg.Type, string(msg.Payload))
+		require.Equal(t, "test_2", msg.ID)
+		require.JSONEq(t, `{"data":{"name":"test"}}`, string(msg.Payload))
+	})
+
+	t.Run("graphql-transport-ws", func(t *testing.T) {
+		c := wsConnectWithSubprotocol(srv.URL, graphqltransportwsSubprotocol)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsConnectionInitMsg}))
+		assert.Equal(t, graphqltransportwsConnectionAckMsg, readOp(c).Type)
+
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    graphqltransportwsSubscribeMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": "subscription Denied { name }"}`),
+		}))
+		msg := readOp(c)
+		require.Equal(t, errorMsg, msg.Type, string(msg.Payload))
+		require.Equal(t, "test_1", msg.ID)
+		require.JSONEq(t, `[{"message":"operation denied"}]`, string(msg.Payload))
+		msg = readOp(c)
+		require.Equal(t, graphqltransportwsCompleteMsg, msg.Type)
+		require.Equal(t, "test_1", msg.ID)
+
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    graphqltransportwsSubscribeMsg,
+			ID:      "test_2",
+			Payload: json.RawMessage(`{"query": "subscription Allowed { name }"}`),
+		}))
+		h.SendNextSubscriptionMessage()
+		msg = readOp(c)
+		require.Equal(t, graphqltransportwsNextMsg, msg.Type, string(msg.Payload))
+		require.Equal(t, "test_2", msg.ID)
+	})
+}
+
+func TestWebsocketMaxSubscriptionsPerConnection(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(transport.Websocket{MaxSubscriptionsPerConnection: 1})
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	c := wsConnect(srv.URL)
+	defer c.Close()
+
+	start := func(id string) {
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    startMsg,
+			ID:      id,
+			Payload: json.RawMessage(`{"query": "subscription { name }"}`),
+		}))
+	}
+	// readData reads the next data message, skipping the completion of earlier subscriptions
+	readData := func(id string) {
+		for {
+			msg := readOp(c)
+			if msg.Type == completeMsg && msg.ID != id {
+				continue
+			}
+			require.Equal(t, dataMsg, msg.Type, string(msg.Payload))
+			require.Equal(t, id, msg.ID)
+			return
+		}
+	}
+
+	require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+	assert.Equal(t, connectionAckMsg, readOp(c).Type)
+	assert.Equal(t, connectionKeepAliveMsg, readOp(c).Type)
+
+	start("test_1")
+
+	t.Run("operations past the limit are rejected", func(t *testing.T) {
+		start("test_2")
+		msg := readOp(c)
+		require.Equal(t, errorMsg, msg.Type, string(msg.Payload))
+		require.Equal(t, "test_2", msg.ID)
+		require.JSONEq(t, `[{"message":"too many subscriptions, at most 1 can run at the same time"}]`, string(msg.Payload))
+		msg = readOp(c)
+		require.Equal(t, completeMsg, msg.Type)
+		require.Equal(t, "test_2", msg.ID)
+
+		h.SendNextSubscriptionMessage()
+		readData("test_1")
+	})
+
+	t.Run("completed subscriptions no longer count", func(t *testing.T) {
+		h.SendCompleteSubscriptionMessage()
+		msg := readOp(c)
+		require.Equal(t, completeMsg, msg.Type)
+		require.Equal(t, "test_1", msg.ID)
+
+		start("test_3")
+		h.SendNextSubscriptionMessage()
+		readData("test_3")
+	})
+
+	t.Run("stopped subscriptions no longer count once they have finished", func(t *testing.T) {
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: stopMsg, ID: "test_3"}))
+		msg := readOp(c)
+		require.Equal(t, completeMsg, msg.Type, string(msg.Payload))
+		require.Equal(t, "test_3", msg.ID)
+
+		start("test_4")
+		h.SendNextSubscriptionMessage()
+		readData("test_4")
+	})
+
+	t.Run("stopped subscriptions count until they have finished", func(t *testing.T) {
+		// the subscription ignores being stopped until it is released
+		release := make(chan struct{})
+		es := &graphql.ExecutableSchemaMock{
+			ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
+				return func(ctx context.Context) *graphql.Response {
+					<-release
+					return nil
+				}
+			},
+			SchemaFunc: func() *ast.Schema {
+				return gqlparser.MustLoadSchema(&ast.Source{Input: `
+					type Query { empty: String }
+					type Subscription { name: String! }
+				`})
+			},
+		}
+		h := handler.New(es)
+		h.AddTransport(transport.Websocket{MaxSubscriptionsPerConnection: 1})
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		c := wsConnectWithSubprotocol(srv.URL, graphqltransportwsSubprotocol)
+		defer c.Close()
+
+		subscribe := func(id string) {
+			require.NoError(t, c.WriteJSON(&operationMessage{
+				Type:    graphqltransportwsSubscribeMsg,
+				ID:      id,
+				Payload: json.RawMessage(`{"query": "subscription { name }"}`),
+			}))
+		}
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsConnectionInitMsg}))
+		assert.Equal(t, graphqltransportwsConnectionAckMsg, readOp(c).Type)
+
+		subscribe("test_1")
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsCompleteMsg, ID: "test_1"}))
+		subscribe("test_2")
+
+		msg := readOp(c)
+		require.Equal(t, errorMsg, msg.Type, string(msg.Payload))
+		require.Equal(t, "test_2", msg.ID)
+		require.JSONEq(t, `[{"message":"too many subscriptions, at most 1 can run at the same time"}]`, string(msg.Payload))
+
+		// the error ends test_2, so the next message is the completion of test_1
+		close(release)
+		msg = readOp(c)
+		require.Equal(t, graphqltransportwsCompleteMsg, msg.Type, string(msg.Payload))
+		require.Equal(t, "test_1", msg.ID)
+
+		subscribe("test_3")
+		msg = readOp(c)
+		require.Equal(t, graphqltransportwsCompleteMsg, msg.Type, string(msg.Payload))
+		require.Equal(t, "test_3", msg.ID)
+	})
+}
+
+func TestDrainWebsockets(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(transport.Websocket{})
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	t.Run("completes subscriptions and closes connections", func(t *testing.T) {
+		legacy := wsConnect(srv.URL)
+		defer legacy.Close()
+		require.NoError(t, legacy.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+		assert.Equal(t, connectionAckMsg, readOp(legacy).Type)
+		assert.Equal(t, connectionKeepAliveMsg, readOp(legacy).Type)
+		require.NoError(t, legacy.WriteJSON(&operationMessage{
+			Type:    startMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": "subscription { name }"}`),
+		}))
+		h.SendNextSubscriptionMessage()
+		assert.Equal(t, dataMsg, readOp(legacy).Type)
+
+		c := wsConnectWithSubprotocol(srv.URL, graphqltransportwsSubprotocol)
+		defer c.Close()
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsConnectionInitMsg}))
+		assert.Equal(t, graphqltransportwsConnectionAckMsg, readOp(c).Type)
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    graphqltransportwsSubscribeMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": "subscription { name }"}`),
+		}))
+		// messages are read in order, so the subscription is running once the ping is answered
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsPingMsg}))
+		assert.Equal(t, graphqltransportwsPongMsg, readOp(c).Type)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		require.NoError(t, transport.DrainWebsockets(ctx))
+
+		msg := readOp(legacy)
+		require.Equal(t, completeMsg, msg.Type)
+		require.Equal(t, "test_1", msg.ID)
+		require.Empty(t, msg.Payload)
+		_, _, err := legacy.ReadMessage()
+		assert.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure), err)
+
+		msg = readOp(c)
+		require.Equal(t, graphqltransportwsCompleteMsg, msg.Type)
+		require.Equal(t, "test_1", msg.ID)
+		require.JSONEq(t, `{"reason":"shutdown"}`, string(msg.Payload))
+		_, _, err = c.ReadMessage()
+		assert.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure), err)
+	})
+
+	t.Run("no error once drained when ctx is done after", func(t *testing.T) {
+		c := wsConnect(srv.URL)
+		defer c.Close()
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+		assert.Equal(t, connectionAckMsg, readOp(c).Type)
+
+		require.NoError(t, transport.DrainWebsockets(expiredContext{context.Background()}))
+	})
+
+	t.Run("closes connections when ctx is done", func(t *testing.T) {
+		// the subscription ignores its context, so it isn't completed before ctx is done
+		release := make(chan struct{})
+		blocking := handler.New(graphqltest.NewMockSchema(`
+			type Query { empty: String }
+			type Subscription { wait: String }
+		`, func(ctx context.Context) graphql.ResponseHandler {
+			return func(ctx context.Context) *graphql.Response {
+				<-release
+				return nil
+			}
+		}))
+		blocking.AddTransport(transport.Websocket{})
+		blockingSrv := httptest.NewServer(blocking)
+		defer blockingSrv.Close()
+		defer close(release)
+
+		c := wsConnectWithSubprotocol(blockingSrv.URL, graphqltransportwsSubprotocol)
+		defer c.Close()
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsConnectionInitMsg}))
+		assert.Equal(t, graphqltransportwsConnectionAckMsg, readOp(c).Type)
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    graphqltransportwsSubscribeMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": "subscription { wait }"}`),
+		}))
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsPingMsg}))
+		assert.Equal(t, graphqltransportwsPongMsg, readOp(c).Type)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		require.ErrorIs(t, transport.DrainWebsockets(ctx), context.Canceled)
+
+		for {
+			_, _, err := c.ReadMessage()
+			if err != nil {
+				assert.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure), err)
+				break
+			}
+		}
+	})
+}
+
+// expiredContext is a context whose deadline passed without its Done channel firing, as when it
+// expires right after the connections were drained.
+type expiredContext struct{ context.Context }
+
+func (expiredContext) Err() error { return context.DeadlineExceeded }
+
+func TestWebsocketMessageCodec(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(transport.Websocket{MessageCodec: msgpackCodec{}})
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	writeMsgpack := func(t *testing.T, c *websocket.Conn, msg map[string]any) {
+		require.NoError(t, c.WriteMessage(websocket.BinaryMessage, msgpackEncode(msg)))
+	}
+	readMsgpack := func(t *testing.T, c *websocket.Conn) map[string]any {
+		messageType, b, err := c.ReadMessage()
+		require.NoError(t, err)
+		require.Equal(t, websocket.BinaryMessage, messageType)
+		v, rest, err := msgpackDecode(b)
+		require.NoError(t, err)
+		require.Empty(t, rest)
+		return v.(map[string]any)
+	}
+
+	t.Run("reads and writes binary messages", func(t *testing.T) {
+		c := wsConnectWithSubprotocol(srv.URL, graphqltransportwsSubprotocol)
+		defer c.Close()
+
+		writeMsgpack(t, c, map[string]any{"type": "connection_init", "payload": map[string]any{}})
+		assert.Equal(t, map[string]any{"type": graphqltransportwsConnectionAckMsg}, readMsgpack(t, c))
+
+		writeMsgpack(t, c, map[string]any{
+			"type":    "subscribe",
+			"id":      "test_1",
+			"payload": map[string]any{"query": "subscription { name }"},
+		})
+		h.SendNextSubscriptionMessage()
+		// the payload is encoded by the codec too, not as JSON text
+		assert.Equal(t, map[string]any{
+			"type":    graphqltransportwsNextMsg,
+			"id":      "test_1",
+			"payload": map[string]any{"data": map[string]any{"name": "test"}},
+		}, readMsgpack(t, c))
+
+		writeMsgpack(t, c, map[string]any{"type": graphqltransportwsCompleteMsg, "id": "test_1"})
+		assert.Equal(t, map[string]any{"type": graphqltransportwsCompleteMsg, "id": "test_1"}, readMsgpack(t, c))
+	})
+
+	t.Run("text messages are still read as JSON", func(t *testing.T) {
+		c := wsConnect(srv.URL)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+		assert.Equal(t, map[string]any{"type": connectionAckMsg}, readMsgpack(t, c))
+	})
+
+	t.Run("binary messages the codec cannot decode are rejected", func(t *testing.T) {
+		c := wsConnect(srv.URL)
+		defer c.Close()
+
+		require.NoError(t, c.WriteMessage(websocket.BinaryMessage, []byte{0xc1}))
+		assert.Equal(t, map[string]any{
+			"type":    connectionErrorMsg,
+			"payload": map[string]any{"message": "invalid json"},
+		}, readMsgpack(t, c))
+	})
+}
+
+// msgpackCodec encodes and decodes the subset of MessagePack used by the tests: maps, strings and nil.
+type msgpackCodec struct{}
+
+func (msgpackCodec) Marshal(v any) ([]byte, error) {
+	return msgpackEncode(v), nil
+}
+
+func (msgpackCodec) Unmarshal(data []byte, v any) error {
+	decoded, rest, err := msgpackDecode(data)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 0 {
+		return errors.New("msgpack: trailing data")
+	}
+	*v.(*any) = decoded
+	return nil
+}
+
+func msgpackDecode(data []byte) (any, []byte, error) {
+	if len(data) == 0 {
+		return nil, nil, errors.New("msgpack: unexpected end of data")
+	}
+	b, data := data[0], data[1:]
+	switch {
+	case b == 0xc0:
+		return nil, data, nil
+	case b&0xe0 == 0xa0:
+		n := int(b & 0x1f)
+		if len(data) < n {
+			return nil, nil, errors.New("msgpack: unexpected end of data")
+		}
+		return string(data[:n]), data[n:], nil
+	case b&0xf0 == 0x80:
+		m := map[string]any{}
+		for i := 0; i < int(b&0x0f); i++ {
+			k, rest, err := msgpackDecode(data)
+			if err != nil {
+				return nil, nil, err
+			}
+			key, ok := k.(string)
+			if !ok {
+				return nil, nil, errors.New("msgpack: map keys must be strings")
+			}
+			if m[key], data, err = msgpackDecode(rest); err != nil {
+				return nil, nil, err
+			}
+		}
+		return m, data, nil
+	default:
+		return nil, nil, fmt.Errorf("msgpack: unsupported type 0x%x", b)
+	}
+}
+
+func msgpackEncode(v any) []byte {
+	switch v := v.(type) {
+	case nil:
+		return []byte{0xc0}
+	case string:
+		return append([]byte{0xa0 | byte(len(v))}, v...)
+	case map[string]any:
+		b := []byte{0x80 | byte(len(v))}
+		for k, e := range v {
+			b = append(b, msgpackEncode(k)...)
+			b = append(b, msgpackEncode(e)...)
+		}
+		return b
+	default:
+		panic(fmt.Sprintf("msgpack: unsupported type %T", v))
+	}
+}
+
+// slowMarshaler blocks the marshalling of a response until release is closed.
+type slowMarshaler chan struct{}
+
+func (m slowMarshaler) MarshalJSON() ([]byte, error) {
+	<-m
+	return []byte(`true`), nil
+}
+
+func TestWebsocketStreamList(t *testing.T) {
+	items := make([]int, 100)
+	for i := range items {
+		items[i] = i
+	}
+
+	es := graphqltest.NewMockSchema(`
+		type Query { empty: String }
+		type Subscription { numbers: [Int!]! }
+	`, func(ctx context.Context) graphql.ResponseHandler {
+		// mimic a subscription resolver streaming its list, and the generated code marshaling each value
+		ch := make(chan []int)
+		go func() {
+			defer close(ch)
+			graphql.StreamList(ctx, ch, items, 30)
+		}()
+		return func(ctx context.Context) *graphql.Response {
+			chunk, ok := <-ch
+			if !ok {
+				return nil
+			}
+			graphql.RegisterListChunk(ctx, ch, chunk)
+			b, _ := json.Marshal(map[string][]int{"numbers": chunk})
+			return &graphql.Response{Data: b}
+		}
+	})
+	h := handler.New(es)
+	h.AddTransport(transport.Websocket{})
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	c := wsConnectWithSubprotocol(srv.URL, graphqltransportwsSubprotocol)
+	defer c.Close()
+
+	require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsConnectionInitMsg}))
+	assert.Equal(t, graphqltransportwsConnectionAckMsg, readOp(c).Type)
+	require.NoError(t, c.WriteJSON(&operationMessage{
+		Type:    graphqltransportwsSubscribeMsg,
+		ID:      "test_1",
+		Payload: json.RawMessage(`{"query": "subscription { numbers }"}`),
+	}))
+
+	var received []int
+	var chunks []graphql.ListChunk
+	for {
+		msg := readOp(c)
+		if msg.Type == graphqltransportwsCompleteMsg {
+			break
+		}
+		require.Equal(t, graphqltransportwsNextMsg, msg.Type, string(msg.Payload))
+
+		var payload struct {
+			Data struct {
+				Numbers []int `json:"numbers"`
+			} `json:"data"`
+			Extensions struct {
+				ListChunk graphql.ListChunk `json:"listChunk"`
+			} `json:"extensions"`
+		}
+		require.NoError(t, json.Unmarshal(msg.Payload, &payload))
+		require.Equal(t, len(received), payload.Extensions.ListChunk.Offset)
+		received = append(received, payload.Data.Numbers...)
+		chunks = append(chunks, payload.Extensions.ListChunk)
+	}
+
+	assert.Equal(t, items, received)
+	assert.Equal(t, []graphql.ListChunk{
+		{Offset: 0, HasNext: true},
+		{Offset: 30, HasNext: true},
+		{Offset: 60, HasNext: true},
+		{Offset: 90, HasNext: false},
+	}, chunks)
+}
+
+func TestWebsocketSkipNextMessageCompression(t *testing.T) {
+	es := &graphql.ExecutableSchemaMock{
+		ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
+			i := 0
+			return func(ctx context.Context) *graphql.Response {
+				i++
+				if i > 2 {
+					return nil
+				}
+				blob := "plain"
+				if i == 1 {
+					blob = "already compressed"
+					transport.SkipNextMessageCompression(ctx)
+				}
+				b, _ := json.Marshal(map[string]string{"blob": blob})
+				return &graphql.Response{Data: b}
+			}
+		},
+		SchemaFunc: func() *ast.Schema {
+			return gqlparser.MustLoadSchema(&ast.Source{Input: `
+				type Query { empty: String }
+				type Subscription { blob: String! }
+			`})
+		},
+	}
+	h := handler.New(es)
+	h.AddTransport(transport.Websocket{
+		Upgrader: websocket.Upgrader{EnableCompression: true},
+	})
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	// record the bytes received from the server to see which frames were compressed on the wire
+	var received bytes.Buffer
+	dialer := websocket.Dialer{
+		EnableCompression: true,
+		NetDialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			conn, err := (&net.Dialer{}).DialContext(ctx, network, addr)
+			if err != nil {
+				return nil, err
+			}
+			return &recordingConn{Conn: conn, received: &received}, nil
+		},
+	}
+	header := make(http.Header)
+	header.Add("Sec-WebSocket-Protocol", graphqltransportwsSubprotocol)
+	c, resp, err := dialer.Dial(strings.ReplaceAll(srv.URL, "http://", "ws://"), header)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	defer c.Close()
+	require.Contains(t, resp.Header.Get("Sec-WebSocket-Extensions"), "permessage-deflate")
+
+	require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsConnectionInitMsg}))
+	assert.Equal(t, graphqltransportwsConnectionAckMsg, readOp(c).Type)
+
+	require.NoError(t, c.WriteJSON(&operationMessage{
+		Type:    graphqltransportwsSubscribeMsg,
+		ID:      "test_1",
+		Payload: json.RawMessage(`{"query": "subscription { blob }"}`),
+	}))
+
+	msg := readOp(c)
+	require.Equal(t, graphqltransportwsNextMsg, msg.Type)
+	require.JSONEq(t, `{"data":{"blob":"already compressed"}}`, string(msg.Payload))
+
+	msg = readOp(c)
+	require.Equal(t, graphqltransportwsNextMsg, msg.Type)
+	require.JSONEq(t, `{"data":{"blob":"plain"}}`, string(msg.Payload))
+
+	assert.Equal(t, graphqltransportwsCompleteMsg, readOp(c).Type)
+
+	// connection_ack, next, next and complete, only the first next is sent without compression
+	frames := readFrames(t, received.Bytes())
+	require.Len(t, frames, 4)
+	require.True(t, frames[0].compressed)
+	require.False(t, frames[1].compressed)
+	require.JSONEq(t, `{"id":"test_1","type":"next","payload":{"data":{"blob":"already compressed"}}}`, string(frames[1].payload))
+	require.True(t, frames[2].compressed)
+	require.True(t, frames[3].compressed)
+}
+
+type frame struct {
+	compressed bool
+	payload    []byte
+}
+
+// readFrames parses the data frames sent by the server in b, the raw bytes of a connection after its
+// handshake. Server frames are never masked.
+func readFrames(t *testing.T, b []byte) []frame {
+	t.Helper()
+	_, b, ok := bytes.Cut(b, []byte("\r\n\r\n"))
+	require.True(t, ok, "missing handshake")
+
+	var frames []frame
+	for len(b) > 0 {
+		require.GreaterOrEqual(t, len(b), 2)
+		opcode := b[0] & 0x0f
+		compressed := b[0]&0x40 != 0 // RSV1 marks messages compressed with permessage-deflate
+		length, header := int(b[1]&0x7f), 2
+		switch length {
+		case 126:
+			length, header = int(binary.BigEndian.Uint16(b[2:4])), 4
+		case 127:
+			length, header = int(binary.BigEndian.Uint64(b[2:10])), 10
+		}
+		require.GreaterOrEqual(t, len(b), header+length)
+		if opcode == websocket.TextMessage || opcode == websocket.BinaryMessage {
+			frames = append(frames, frame{compressed: compressed, payload: b[header : header+length]})
+		}
+		b = b[header+length:]
+	}
+	return frames
+}
+
+type recordingConn struct {
+	net.Conn
+	received *bytes.Buffer
+}
+
+func (c *recordingConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.received.Write(b[:n])
+	return n, err
+}
+
+func TestWebsocketBytesWritten(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(transport.Websocket{})
+
+	reported := make(chan int64, 1)
+	h.SetBytesWrittenFunc(func(ctx context.Context, bytes int64) {
+		reported <- bytes
+	})
+
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	c := wsConnectWithSubprotocol(srv.URL, graphqltransportwsSubprotocol)
+	defer c.Close()
+
+	require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsConnectionInitMsg}))
+	assert.Equal(t, graphqltransportwsConnectionAckMsg, readOp(c).Type)
+
+	require.NoError(t, c.WriteJSON(&operationMessage{
+		Type:    graphqltransportwsSubscribeMsg,
+		ID:      "test_1",
+		Payload: json.RawMessage(`{"query": "subscription { name }"}`),
+	}))
+
+	var written int
+	read := func(expectedType string) {
+		_, b, err := c.ReadMessage()
+		require.NoError(t, err)
+		var msg operationMessage
+		require.NoError(t, json.Unmarshal(b, &msg))
+		require.Equal(t, expectedType, msg.Type, string(b))
+		written += len(b)
+	}
+
+	h.SendNextSubscriptionMessage()
+	read(graphqltransportwsNextMsg)
+	h.SendNextSubscriptionMessage()
+	read(graphqltransportwsNextMsg)
+	h.SendCompleteSubscriptionMessage()
+	read(graphqltransportwsCompleteMsg)
+
+	require.EqualValues(t, written, <-reported)
+}
+
 func TestWebsocketWithKeepAlive(t *testing.T) {
 	h := testserver.New()
 	h.AddTransport(transport.Websocket{
@@ -189,6 +1351,41 @@ func TestWebsocketWithKeepAlive(t *testing.T) {
 	assert.Equal(t, connectionKeepAliveMsg, msg.Type)
 }
 
+func TestWebsocketWithKeepAliveOnlyWhenIdle(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 200 * time.Millisecond,
+		KeepAliveOnlyWhenIdle: true,
+	})
+
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	c := wsConnect(srv.URL)
+	defer c.Close()
+
+	require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+	assert.Equal(t, connectionAckMsg, readOp(c).Type)
+	assert.Equal(t, connectionKeepAliveMsg, readOp(c).Type)
+
+	require.NoError(t, c.WriteJSON(&operationMessage{
+		Type:    startMsg,
+		ID:      "test_1",
+		Payload: json.RawMessage(`{"query": "subscription { name }"}`),
+	}))
+
+	// data flows for well over the keep-alive interval, without any keep-alive in between
+	for range 20 {
+		h.SendNextSubscriptionMessage()
+		assert.Equal(t, dataMsg, readOp(c).Type)
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	// keep-alives resume once the connection is idle
+	assert.Equal(t, connectionKeepAliveMsg, readOp(c).Type)
+	assert.Equal(t, connectionKeepAliveMsg, readOp(c).Type)
+}
+
 func TestWebsocketWithPassedHeaders(t *testing.T) {
 	h := testserver.New()
 	h.AddTransport(transport.Websocket{
@@ -231,6 +1428,139 @@ func TestWebsocketWithPassedHeaders(t *testing.T) {
 	assert.Equal(t, connectionKeepAliveMsg, msg.Type)
 }
 
+func TestWebsocketGetSubprotocol(t *testing.T) {
+	subprotocols := make(chan string, 1)
+	h := testserver.New()
+	h.AddTransport(transport.Websocket{})
+	h.AddTransport(transport.POST{})
+	h.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		subprotocols <- transport.GetSubprotocol(ctx)
+		return next(ctx)
+	})
+
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	t.Run("graphql-ws", func(t *testing.T) {
+		c := wsConnectWithSubprotocol(srv.URL, graphqlwsSubprotocol)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+		assert.Equal(t, connectionAckMsg, readOp(c).Type)
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    startMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": "subscription { name }"}`),
+		}))
+		assert.Equal(t, "graphql-ws", <-subprotocols)
+	})
+
+	t.Run("graphql-ws is used when no subprotocol is negotiated", func(t *testing.T) {
+		c := wsConnect(srv.URL)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+		assert.Equal(t, connectionAckMsg, readOp(c).Type)
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    startMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": "subscription { name }"}`),
+		}))
+		assert.Equal(t, "graphql-ws", <-subprotocols)
+	})
+
+	t.Run("graphql-transport-ws", func(t *testing.T) {
+		c := wsConnectWithSubprotocol(srv.URL, graphqltransportwsSubprotocol)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsConnectionInitMsg}))
+		assert.Equal(t, graphqltransportwsConnectionAckMsg, readOp(c).Type)
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    graphqltransportwsSubscribeMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": "subscription { name }"}`),
+		}))
+		assert.Equal(t, "graphql-transport-ws", <-subprotocols)
+	})
+
+	t.Run("empty outside of websockets", func(t *testing.T) {
+		resp := doRequest(h, http.MethodPost, "/graphql", `{"query":"{ name }"}`, "", "application/json")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, <-subprotocols)
+	})
+}
+
+func TestWebsocketGetInitRequest(t *testing.T) {
+	hosts := make(chan string, 1)
+	h := testserver.New()
+	h.AddTransport(transport.Websocket{
+		InitFunc: func(ctx context.Context, _ transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+			if r := transport.GetInitRequest(ctx); r == nil || r.Header.Get("X-Tenant") != "acme" {
+				return ctx, nil, errors.New("missing the upgrade request")
+			}
+			return ctx, nil, nil
+		},
+	})
+	h.AddTransport(transport.POST{})
+	h.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		if r := transport.GetInitRequest(ctx); r != nil {
+			hosts <- r.Host
+		} else {
+			hosts <- ""
+		}
+		return next(ctx)
+	})
+
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+	host := strings.TrimPrefix(srv.URL, "http://")
+
+	dial := func(t *testing.T, subprotocol string) *websocket.Conn {
+		header := http.Header{"X-Tenant": []string{"acme"}}
+		if subprotocol != "" {
+			header.Set("Sec-WebSocket-Protocol", subprotocol)
+		}
+		c, resp, err := websocket.DefaultDialer.Dial(strings.ReplaceAll(srv.URL, "http://", "ws://"), header)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return c
+	}
+
+	t.Run("graphql-ws", func(t *testing.T) {
+		c := dial(t, graphqlwsSubprotocol)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+		assert.Equal(t, connectionAckMsg, readOp(c).Type)
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    startMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": "subscription { name }"}`),
+		}))
+		assert.Equal(t, host, <-hosts)
+	})
+
+	t.Run("graphql-transport-ws", func(t *testing.T) {
+		c := dial(t, graphqltransportwsSubprotocol)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsConnectionInitMsg}))
+		assert.Equal(t, graphqltransportwsConnectionAckMsg, readOp(c).Type)
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    graphqltransportwsSubscribeMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": "subscription { name }"}`),
+		}))
+		assert.Equal(t, host, <-hosts)
+	})
+
+	t.Run("nil outside of websockets", func(t *testing.T) {
+		resp := doRequest(h, http.MethodPost, "/graphql", `{"query":"{ name }"}`, "", "application/json")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, <-hosts)
+	})
+}
+
 func TestWebsocketInitFunc(t *testing.T) {
 	t.Run("accept connection if WebsocketInitFunc is NOT provided", func(t *testing.T) {
 		h := testserver.New()
@@ -286,6 +1616,29 @@ func TestWebsocketInitFunc(t *testing.T) {
 		assert.JSONEq(t, `{"message":"invalid init payload"}`, string(msg.Payload))
 	})
 
+	t.Run("reject connection with a structured error from WebsocketInitFunc", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.Websocket{
+			InitFunc: func(ctx context.Context, initPayload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+				return ctx, nil, fmt.Errorf("init: %w", &connectionError{
+					message: "token expired",
+					code:    "UNAUTHENTICATED",
+				})
+			},
+		})
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		c := wsConnect(srv.URL)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+
+		msg := readOp(c)
+		assert.Equal(t, connectionErrorMsg, msg.Type)
+		assert.JSONEq(t, `{"message":"token expired","extensions":{"code":"UNAUTHENTICATED"}}`, string(msg.Payload))
+	})
+
 	t.Run("can return context for request from WebsocketInitFunc", func(t *testing.T) {
 		es := &graphql.ExecutableSchemaMock{
 			ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
@@ -321,6 +1674,39 @@ func TestWebsocketInitFunc(t *testing.T) {
 		assert.Equal(t, "ok", resp.Empty)
 	})
 
+	t.Run("operations can reach the connection context", func(t *testing.T) {
+		es := graphqltest.NewMockSchema(`
+			type Query { empty: String }
+			type Subscription { value: String }
+		`, func(ctx context.Context) graphql.ResponseHandler {
+			// the operation shadows the connection value, which stays reachable through ConnectionContext
+			assert.Equal(t, "operation", ctx.Value(ckey("scope")))
+			connCtx := transport.ConnectionContext(ctx)
+			require.NotNil(t, connCtx)
+			value, _ := connCtx.Value(ckey("scope")).(string)
+			return graphql.OneShot(&graphql.Response{Data: []byte(`{"value":"` + value + `"}`)})
+		})
+		h := handler.New(es)
+		h.AddTransport(transport.Websocket{
+			InitFunc: func(ctx context.Context, initPayload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+				return context.WithValue(ctx, ckey("scope"), "connection"), nil, nil
+			},
+		})
+		h.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+			return next(context.WithValue(ctx, ckey("scope"), "operation"))
+		})
+
+		c := client.New(h)
+
+		socket := c.Websocket("subscription { value }")
+		defer socket.Close()
+		var resp struct {
+			Value string
+		}
+		require.NoError(t, socket.Next(&resp))
+		assert.Equal(t, "connection", resp.Value)
+	})
+
 	t.Run("can set a deadline on a websocket connection and close it with a reason", func(t *testing.T) {
 		h := testserver.New()
 		var cancel func()
@@ -373,6 +1759,85 @@ func TestWebsocketInitFunc(t *testing.T) {
 		assert.EqualValues(t, "123-456", payload["trackingId"])
 		assert.Equal(t, connectionKeepAliveMsg, readOp(c).Type)
 	})
+
+	t.Run("graphql-ws ack only has a payload when WebsocketInitFunc returns one", func(t *testing.T) {
+		var ackPayload *transport.InitPayload
+		h := testserver.New()
+		h.AddTransport(transport.Websocket{
+			InitFunc: func(ctx context.Context, initPayload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+				return ctx, ackPayload, nil
+			},
+		})
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		readAck := func() string {
+			c := wsConnectWithSubprotocol(srv.URL, graphqlwsSubprotocol)
+			defer c.Close()
+
+			require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+			_, b, err := c.ReadMessage()
+			require.NoError(t, err)
+			return string(b)
+		}
+
+		ackPayload = &transport.InitPayload{"trackingId": "123-456"}
+		assert.JSONEq(t, `{"type":"connection_ack","payload":{"trackingId":"123-456"}}`, readAck())
+
+		ackPayload = nil
+		assert.JSONEq(t, `{"type":"connection_ack"}`, readAck())
+	})
+}
+
+func TestWebsocketConnectionAckFunc(t *testing.T) {
+	t.Run("advertises capabilities in the connection ack", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.Websocket{
+			InitFunc: func(ctx context.Context, initPayload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+				return ctx, &transport.InitPayload{"trackingId": "123-456", "version": "init"}, nil
+			},
+			ConnectionAckFunc: func(ctx context.Context, initPayload transport.InitPayload) (map[string]any, error) {
+				assert.Equal(t, "client", initPayload["name"])
+				return map[string]any{
+					"compression":      false,
+					"maxSubscriptions": 10,
+					"version":          "1.0",
+				}, nil
+			},
+		})
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		c := wsConnect(srv.URL)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg, Payload: json.RawMessage(`{"name":"client"}`)}))
+
+		connAck := readOp(c)
+		assert.Equal(t, connectionAckMsg, connAck.Type)
+		assert.JSONEq(t, `{"trackingId":"123-456","compression":false,"maxSubscriptions":10,"version":"1.0"}`, string(connAck.Payload))
+		assert.Equal(t, connectionKeepAliveMsg, readOp(c).Type)
+	})
+
+	t.Run("reject connection if ConnectionAckFunc returns an error", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.Websocket{
+			ConnectionAckFunc: func(ctx context.Context, initPayload transport.InitPayload) (map[string]any, error) {
+				return nil, errors.New("unsupported client")
+			},
+		})
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		c := wsConnect(srv.URL)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+
+		msg := readOp(c)
+		assert.Equal(t, connectionErrorMsg, msg.Type)
+		assert.JSONEq(t, `{"message":"unsupported client"}`, string(msg.Payload))
+	})
 }
 
 func TestWebSocketInitTimeout(t *testing.T) {
@@ -393,6 +1858,44 @@ func TestWebSocketInitTimeout(t *testing.T) {
 		assert.Contains(t, err.Error(), "timeout")
 	})
 
+	t.Run("closes with the default code and reason on timeout", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.Websocket{
+			InitTimeout: 5 * time.Millisecond,
+		})
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		c := wsConnect(srv.URL)
+		defer c.Close()
+
+		_, _, err := c.ReadMessage()
+		var closeErr *websocket.CloseError
+		require.ErrorAs(t, err, &closeErr)
+		assert.Equal(t, websocket.CloseProtocolError, closeErr.Code)
+		assert.Equal(t, "connection initialisation timeout", closeErr.Text)
+	})
+
+	t.Run("closes with the configured code and reason on timeout", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.Websocket{
+			InitTimeout:            5 * time.Millisecond,
+			InitTimeoutCloseCode:   4408,
+			InitTimeoutCloseReason: "please send connection_init sooner",
+		})
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		c := wsConnect(srv.URL)
+		defer c.Close()
+
+		_, _, err := c.ReadMessage()
+		var closeErr *websocket.CloseError
+		require.ErrorAs(t, err, &closeErr)
+		assert.Equal(t, 4408, closeErr.Code)
+		assert.Equal(t, "please send connection_init sooner", closeErr.Text)
+	})
+
 	t.Run("keeps waiting for an init message if no time out is configured", func(t *testing.T) {
 		h := testserver.New()
 		h.AddTransport(transport.Websocket{})
@@ -672,6 +2175,88 @@ func TestWebsocketGraphqltransportwsSubprotocol(t *testing.T) {
 	})
 }
 
+func TestWebsocketCompletionReason(t *testing.T) {
+	// initialize serves a subscription sending a single message, before completing with reason
+	initialize := func(reason string) *httptest.Server {
+		h := handler.New(graphqltest.NewMockSchema(`
+			type Query { empty: String }
+			type Subscription { name: String! }
+		`, func(ctx context.Context) graphql.ResponseHandler {
+			sent := false
+			return func(ctx context.Context) *graphql.Response {
+				if sent {
+					if reason != "" {
+						transport.SetCompletionReason(ctx, reason)
+					}
+					return nil
+				}
+				sent = true
+				return &graphql.Response{Data: []byte(`{"name":"test"}`)}
+			}
+		}))
+		h.AddTransport(transport.Websocket{})
+		return httptest.NewServer(h)
+	}
+
+	subscribe := func(t *testing.T, c *websocket.Conn) operationMessage {
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsConnectionInitMsg}))
+		assert.Equal(t, graphqltransportwsConnectionAckMsg, readOp(c).Type)
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    graphqltransportwsSubscribeMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": "subscription { name }"}`),
+		}))
+		assert.Equal(t, graphqltransportwsNextMsg, readOp(c).Type)
+
+		msg := readOp(c)
+		require.Equal(t, graphqltransportwsCompleteMsg, msg.Type)
+		require.Equal(t, "test_1", msg.ID)
+		return msg
+	}
+
+	t.Run("server initiated completion sends its reason", func(t *testing.T) {
+		srv := initialize(transport.CompletionReasonShutdown)
+		defer srv.Close()
+
+		c := wsConnectWithSubprotocol(srv.URL, graphqltransportwsSubprotocol)
+		defer c.Close()
+
+		require.JSONEq(t, `{"reason":"shutdown"}`, string(subscribe(t, c).Payload))
+	})
+
+	t.Run("completion without a reason has no payload", func(t *testing.T) {
+		srv := initialize("")
+		defer srv.Close()
+
+		c := wsConnectWithSubprotocol(srv.URL, graphqltransportwsSubprotocol)
+		defer c.Close()
+
+		require.Empty(t, subscribe(t, c).Payload)
+	})
+
+	t.Run("graphql-ws clients get a bare complete", func(t *testing.T) {
+		srv := initialize(transport.CompletionReasonShutdown)
+		defer srv.Close()
+
+		c := wsConnect(srv.URL)
+		defer c.Close()
+
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+		assert.Equal(t, connectionAckMsg, readOp(c).Type)
+		assert.Equal(t, connectionKeepAliveMsg, readOp(c).Type)
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    startMsg,
+			ID:      "test_1",
+			Payload: json.RawMessage(`{"query": "subscription { name }"}`),
+		}))
+		assert.Equal(t, dataMsg, readOp(c).Type)
+
+		msg := readOp(c)
+		require.Equal(t, completeMsg, msg.Type)
+		require.Empty(t, msg.Payload)
+	})
+}
+
 func TestWebsocketWithPingPongInterval(t *testing.T) {
 	initialize := func(ws transport.Websocket) (*testserver.TestServer, *httptest.Server) {
 		h := testserver.New()
@@ -835,6 +2420,22 @@ func TestWebsocketWithPingPongInterval(t *testing.T) {
 	})
 }
 
+type connectionError struct {
+	message string
+	code    string
+}
+
+func (e *connectionError) Error() string {
+	return e.message
+}
+
+func (e *connectionError) ConnectionErrorPayload() map[string]any {
+	return map[string]any{
+		"message":    e.message,
+		"extensions": map[string]any{"code": e.code},
+	}
+}
+
 func wsConnect(url string) *websocket.Conn {
 	return wsConnectWithSubprotocol(url, "")
 }
@@ -871,6 +2472,8 @@ func readOp(conn *websocket.Conn) operationMessage {
 // copied out from websocket_graphqlws.go to keep these private
 
 const (
+	graphqlwsSubprotocol = "graphql-ws"
+
 	connectionInitMsg      = "connection_init"      // Client -> Server
 	connectionTerminateMsg = "connection_terminate" // Client -> Server
 	startMsg               = "start"                // Client -> Server
@@ -881,6 +2484,7 @@ const (
 	errorMsg               = "error"                // Server -> Client
 	completeMsg            = "complete"             // Server -> Client
 	connectionKeepAliveMsg = "ka"                   // Server -> Client
+	requestMsg             = "request"              // Client -> Server
 )
 
 // copied out from websocket_graphql_transport_ws.go to keep these private
@@ -895,6 +2499,7 @@ const (
 	graphqltransportwsCompleteMsg       = "complete"
 	graphqltransportwsPingMsg           = "ping"
 	graphqltransportwsPongMsg           = "pong"
+	graphqltransportwsRequestMsg        = "request"
 )
 
 type operationMessage struct {
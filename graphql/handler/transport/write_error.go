@@ -0,0 +1,55 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// WriteErrorFunc is called when writing a response fails, eg. because the client disconnected
+// before a large response was written. It is called once per request, with the first error, after
+// the transport is done with the request. ctx carries the operation context when the request got
+// that far, so the operation can be identified by its name or RequestID.
+type WriteErrorFunc func(ctx context.Context, err error)
+
+// writeErrorWriter records the first error returned by the writes to a response.
+type writeErrorWriter struct {
+	http.ResponseWriter
+	mu  sync.Mutex
+	err error
+}
+
+// withWriteErrorFunc returns w with its write errors recorded, and a func to call once the response
+// is done, that reports the recorded error to f with ctx. If f is nil, w is returned as is.
+func withWriteErrorFunc(w http.ResponseWriter, f WriteErrorFunc) (http.ResponseWriter, func(ctx context.Context)) {
+	if f == nil {
+		return w, func(context.Context) {}
+	}
+	ew := &writeErrorWriter{ResponseWriter: w}
+	return ew, func(ctx context.Context) {
+		ew.mu.Lock()
+		err := ew.err
+		ew.mu.Unlock()
+		if err != nil {
+			f(ctx, err)
+		}
+	}
+}
+
+func (w *writeErrorWriter) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	if err != nil {
+		w.mu.Lock()
+		if w.err == nil {
+			w.err = err
+		}
+		w.mu.Unlock()
+	}
+	return n, err
+}
+
+func (w *writeErrorWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
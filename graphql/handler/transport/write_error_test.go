@@ -0,0 +1,103 @@
+package transport_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+// brokenPipeWriter fails every write, like the response of a client that disconnected.
+type brokenPipeWriter struct {
+	*httptest.ResponseRecorder
+}
+
+func (brokenPipeWriter) Write([]byte) (int, error) {
+	return 0, syscall.EPIPE
+}
+
+func TestOnWriteError(t *testing.T) {
+	type writeError struct {
+		operationName string
+		err           error
+	}
+	var reported []writeError
+	onWriteError := func(ctx context.Context, err error) {
+		var operationName string
+		if graphql.HasOperationContext(ctx) {
+			operationName = graphql.GetOperationContext(ctx).Operation.Name
+		}
+		reported = append(reported, writeError{operationName: operationName, err: err})
+	}
+
+	h := testserver.New()
+	h.AddTransport(transport.SSE{OnWriteError: onWriteError})
+	h.AddTransport(transport.GET{OnWriteError: onWriteError})
+	h.AddTransport(transport.POST{OnWriteError: onWriteError})
+
+	serve := func(r *http.Request) {
+		reported = nil
+		h.ServeHTTP(brokenPipeWriter{httptest.NewRecorder()}, r)
+	}
+
+	t.Run("POST", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":"query Name { name }"}`))
+		r.Header.Set("Content-Type", "application/json")
+		serve(r)
+
+		require.Len(t, reported, 1)
+		assert.Equal(t, "Name", reported[0].operationName)
+		assert.ErrorIs(t, reported[0].err, syscall.EPIPE)
+	})
+
+	t.Run("POST request error", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":`))
+		r.Header.Set("Content-Type", "application/json")
+		serve(r)
+
+		require.Len(t, reported, 1)
+		assert.ErrorIs(t, reported[0].err, syscall.EPIPE)
+	})
+
+	t.Run("GET", func(t *testing.T) {
+		serve(httptest.NewRequest(http.MethodGet, "/graphql?query=query+Name+{+name+}", nil))
+
+		require.Len(t, reported, 1)
+		assert.Equal(t, "Name", reported[0].operationName)
+		assert.ErrorIs(t, reported[0].err, syscall.EPIPE)
+	})
+
+	t.Run("SSE", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":"subscription Name { name }"}`))
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set("Accept", "text/event-stream")
+		go func() {
+			h.SendCompleteSubscriptionMessage()
+		}()
+		serve(r)
+
+		require.Len(t, reported, 1)
+		assert.Equal(t, "Name", reported[0].operationName)
+		assert.ErrorIs(t, reported[0].err, syscall.EPIPE)
+	})
+
+	t.Run("not called when the response is written", func(t *testing.T) {
+		reported = nil
+		r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":"{ name }"}`))
+		r.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, reported)
+	})
+}
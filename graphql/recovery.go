@@ -18,3 +18,40 @@ func DefaultRecover(ctx context.Context, err any) error {
 
 	return gqlerror.Errorf("internal system error")
 }
+
+// RecoveredOperation describes the operation that was executing when a panic was recovered, so it
+// can be logged alongside the panic. It must not be returned to the client.
+type RecoveredOperation struct {
+	OperationName string
+	RawQuery      string
+	// Variables holds the names of the operation's variables, with their values redacted.
+	Variables map[string]any
+}
+
+// OperationRecoverFunc is a RecoverFunc that also receives the operation that panicked. op is nil
+// when the panic happened outside of an operation, eg. in a transport.
+type OperationRecoverFunc func(ctx context.Context, err any, op *RecoveredOperation) (userMessage error)
+
+const redactedVariable = "[redacted]"
+
+// RecoverWithOperation adapts f for use with SetRecoverFunc.
+func RecoverWithOperation(f OperationRecoverFunc) RecoverFunc {
+	return func(ctx context.Context, err any) error {
+		var op *RecoveredOperation
+		if HasOperationContext(ctx) {
+			opCtx := GetOperationContext(ctx)
+			op = &RecoveredOperation{
+				OperationName: opCtx.OperationName,
+				RawQuery:      opCtx.RawQuery,
+				Variables:     make(map[string]any, len(opCtx.Variables)),
+			}
+			if opCtx.Operation != nil {
+				op.OperationName = opCtx.Operation.Name
+			}
+			for name := range opCtx.Variables {
+				op.Variables[name] = redactedVariable
+			}
+		}
+		return f(ctx, err, op)
+	}
+}
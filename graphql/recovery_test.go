@@ -0,0 +1,43 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecoverWithOperation(t *testing.T) {
+	var (
+		recovered any
+		operation *RecoveredOperation
+	)
+	recoverFunc := RecoverWithOperation(func(ctx context.Context, err any, op *RecoveredOperation) error {
+		recovered, operation = err, op
+		return errors.New("internal system error")
+	})
+
+	t.Run("with operation context", func(t *testing.T) {
+		ctx := WithOperationContext(context.Background(), &OperationContext{
+			OperationName: "GetUser",
+			RawQuery:      "query GetUser($id: ID!) { user(id: $id) { name } }",
+			Variables:     map[string]any{"id": "secret"},
+		})
+
+		err := recoverFunc(ctx, "boom")
+		require.EqualError(t, err, "internal system error")
+		require.Equal(t, "boom", recovered)
+		require.Equal(t, &RecoveredOperation{
+			OperationName: "GetUser",
+			RawQuery:      "query GetUser($id: ID!) { user(id: $id) { name } }",
+			Variables:     map[string]any{"id": "[redacted]"},
+		}, operation)
+	})
+
+	t.Run("without operation context", func(t *testing.T) {
+		err := recoverFunc(context.Background(), "boom")
+		require.EqualError(t, err, "internal system error")
+		require.Nil(t, operation)
+	})
+}
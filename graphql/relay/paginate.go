@@ -0,0 +1,102 @@
+// Package relay contains runtime helpers for serving relay style connections.
+package relay
+
+import (
+	"errors"
+)
+
+// PageInfo matches the PageInfo type from the relay cursor connections specification.
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor,omitempty"`
+	EndCursor       *string `json:"endCursor,omitempty"`
+}
+
+// Edge wraps a single node in a connection along with the cursor pointing at it.
+type Edge[T any] struct {
+	Node   T      `json:"node"`
+	Cursor string `json:"cursor"`
+}
+
+// Args holds the standard connection arguments. Nil values mean the argument was not provided.
+type Args struct {
+	First  *int
+	After  *string
+	Last   *int
+	Before *string
+}
+
+// CursorFunc returns an opaque cursor for the item at the given index in the full list.
+type CursorFunc[T any] func(index int, item T) string
+
+var (
+	ErrNegativeFirst = errors.New("first must be a non-negative integer")
+	ErrNegativeLast  = errors.New("last must be a non-negative integer")
+)
+
+// Paginate slices items according to args, following the pagination algorithm from the relay
+// cursor connections specification. items must be the complete, ordered list, which allows
+// hasNextPage and hasPreviousPage to be computed exactly in both directions.
+//
+// Cursors passed in after and before that do not match any item are ignored.
+func Paginate[T any](items []T, args Args, encodeCursor CursorFunc[T]) ([]*Edge[T], *PageInfo, error) {
+	if args.First != nil && *args.First < 0 {
+		return nil, nil, ErrNegativeFirst
+	}
+	if args.Last != nil && *args.Last < 0 {
+		return nil, nil, ErrNegativeLast
+	}
+
+	cursors := make([]string, len(items))
+	for i, item := range items {
+		cursors[i] = encodeCursor(i, item)
+	}
+
+	start, end := 0, len(items)
+	if args.After != nil {
+		if i := indexOf(cursors, *args.After); i >= 0 {
+			start = i + 1
+		}
+	}
+	if args.Before != nil {
+		if i := indexOf(cursors, *args.Before); i >= 0 && i < end {
+			end = i
+		}
+	}
+	if start > end {
+		start = end
+	}
+
+	if args.First != nil && end-start > *args.First {
+		end = start + *args.First
+	}
+	if args.Last != nil && end-start > *args.Last {
+		start = end - *args.Last
+	}
+
+	edges := make([]*Edge[T], 0, end-start)
+	for i := start; i < end; i++ {
+		edges = append(edges, &Edge[T]{Node: items[i], Cursor: cursors[i]})
+	}
+
+	pageInfo := &PageInfo{
+		HasPreviousPage: start > 0,
+		HasNextPage:     end < len(items),
+	}
+	if len(edges) > 0 {
+		pageInfo.StartCursor = &edges[0].Cursor
+		pageInfo.EndCursor = &edges[len(edges)-1].Cursor
+	}
+
+	return edges, pageInfo, nil
+}
+
+func indexOf(cursors []string, cursor string) int {
+	for i, c := range cursors {
+		if c == cursor {
+			return i
+		}
+	}
+	return -1
+}
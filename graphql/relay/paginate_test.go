@@ -0,0 +1,155 @@
+package relay
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func cursor(index int, _ string) string {
+	return "cursor:" + strconv.Itoa(index)
+}
+
+func nodes(edges []*Edge[string]) []string {
+	out := make([]string, 0, len(edges))
+	for _, e := range edges {
+		out = append(out, e.Node)
+	}
+	return out
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestPaginate(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e"}
+
+	t.Run("no arguments returns everything", func(t *testing.T) {
+		edges, pageInfo, err := Paginate(items, Args{}, cursor)
+		require.NoError(t, err)
+		assert.Equal(t, items, nodes(edges))
+		assert.False(t, pageInfo.HasNextPage)
+		assert.False(t, pageInfo.HasPreviousPage)
+		assert.Equal(t, "cursor:0", *pageInfo.StartCursor)
+		assert.Equal(t, "cursor:4", *pageInfo.EndCursor)
+	})
+
+	t.Run("forward first", func(t *testing.T) {
+		edges, pageInfo, err := Paginate(items, Args{First: ptr(2)}, cursor)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, nodes(edges))
+		assert.True(t, pageInfo.HasNextPage)
+		assert.False(t, pageInfo.HasPreviousPage)
+		assert.Equal(t, "cursor:1", *pageInfo.EndCursor)
+	})
+
+	t.Run("forward first after", func(t *testing.T) {
+		edges, pageInfo, err := Paginate(items, Args{First: ptr(2), After: ptr("cursor:1")}, cursor)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"c", "d"}, nodes(edges))
+		assert.True(t, pageInfo.HasNextPage)
+		assert.True(t, pageInfo.HasPreviousPage)
+		assert.Equal(t, "cursor:2", *pageInfo.StartCursor)
+		assert.Equal(t, "cursor:3", *pageInfo.EndCursor)
+	})
+
+	t.Run("forward last page", func(t *testing.T) {
+		edges, pageInfo, err := Paginate(items, Args{First: ptr(2), After: ptr("cursor:3")}, cursor)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"e"}, nodes(edges))
+		assert.False(t, pageInfo.HasNextPage)
+		assert.True(t, pageInfo.HasPreviousPage)
+	})
+
+	t.Run("backward last", func(t *testing.T) {
+		edges, pageInfo, err := Paginate(items, Args{Last: ptr(2)}, cursor)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"d", "e"}, nodes(edges))
+		assert.False(t, pageInfo.HasNextPage)
+		assert.True(t, pageInfo.HasPreviousPage)
+	})
+
+	t.Run("backward last before", func(t *testing.T) {
+		edges, pageInfo, err := Paginate(items, Args{Last: ptr(2), Before: ptr("cursor:3")}, cursor)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"b", "c"}, nodes(edges))
+		assert.True(t, pageInfo.HasNextPage)
+		assert.True(t, pageInfo.HasPreviousPage)
+	})
+
+	t.Run("backward first page", func(t *testing.T) {
+		edges, pageInfo, err := Paginate(items, Args{Last: ptr(2), Before: ptr("cursor:1")}, cursor)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a"}, nodes(edges))
+		assert.True(t, pageInfo.HasNextPage)
+		assert.False(t, pageInfo.HasPreviousPage)
+	})
+
+	t.Run("after and before", func(t *testing.T) {
+		edges, pageInfo, err := Paginate(items, Args{After: ptr("cursor:0"), Before: ptr("cursor:4")}, cursor)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"b", "c", "d"}, nodes(edges))
+		assert.True(t, pageInfo.HasNextPage)
+		assert.True(t, pageInfo.HasPreviousPage)
+	})
+
+	t.Run("unknown cursors are ignored", func(t *testing.T) {
+		edges, _, err := Paginate(items, Args{After: ptr("nope"), Before: ptr("nope")}, cursor)
+		require.NoError(t, err)
+		assert.Equal(t, items, nodes(edges))
+	})
+
+	t.Run("first larger than the list", func(t *testing.T) {
+		edges, pageInfo, err := Paginate(items, Args{First: ptr(10)}, cursor)
+		require.NoError(t, err)
+		assert.Equal(t, items, nodes(edges))
+		assert.False(t, pageInfo.HasNextPage)
+		assert.False(t, pageInfo.HasPreviousPage)
+	})
+
+	t.Run("first zero", func(t *testing.T) {
+		edges, pageInfo, err := Paginate(items, Args{First: ptr(0)}, cursor)
+		require.NoError(t, err)
+		assert.Empty(t, edges)
+		assert.True(t, pageInfo.HasNextPage)
+		assert.Nil(t, pageInfo.StartCursor)
+		assert.Nil(t, pageInfo.EndCursor)
+	})
+
+	t.Run("empty list", func(t *testing.T) {
+		edges, pageInfo, err := Paginate([]string{}, Args{First: ptr(2)}, cursor)
+		require.NoError(t, err)
+		assert.Empty(t, edges)
+		assert.False(t, pageInfo.HasNextPage)
+		assert.False(t, pageInfo.HasPreviousPage)
+		assert.Nil(t, pageInfo.StartCursor)
+		assert.Nil(t, pageInfo.EndCursor)
+	})
+
+	t.Run("after the last item", func(t *testing.T) {
+		edges, pageInfo, err := Paginate(items, Args{First: ptr(2), After: ptr("cursor:4")}, cursor)
+		require.NoError(t, err)
+		assert.Empty(t, edges)
+		assert.False(t, pageInfo.HasNextPage)
+		assert.True(t, pageInfo.HasPreviousPage)
+	})
+
+	t.Run("before precedes after", func(t *testing.T) {
+		edges, _, err := Paginate(items, Args{After: ptr("cursor:3"), Before: ptr("cursor:1")}, cursor)
+		require.NoError(t, err)
+		assert.Empty(t, edges)
+	})
+
+	t.Run("negative first", func(t *testing.T) {
+		_, _, err := Paginate(items, Args{First: ptr(-1)}, cursor)
+		require.ErrorIs(t, err, ErrNegativeFirst)
+	})
+
+	t.Run("negative last", func(t *testing.T) {
+		_, _, err := Paginate(items, Args{Last: ptr(-1)}, cursor)
+		require.ErrorIs(t, err, ErrNegativeLast)
+	})
+}
@@ -0,0 +1,81 @@
+package graphql
+
+import (
+	"context"
+	"reflect"
+	"sync"
+)
+
+// ListChunk describes the part of a list sent by StreamList in a subscription response. It is added
+// to the response as the listChunk extension, so clients can append the elements to the elements
+// they already received.
+type ListChunk struct {
+	// Offset is the index of the first element of the chunk in the streamed list.
+	Offset int `json:"offset"`
+	// HasNext is true when more chunks of the same list follow.
+	HasNext bool `json:"hasNext"`
+}
+
+// listChunkKey identifies a chunk by the channel it is sent on and the elements it holds, so the chunk
+// travels with its value whatever else is sent on the channel.
+type listChunkKey struct {
+	ch    uintptr
+	first any
+	len   int
+}
+
+// listChunks holds the chunks sent by StreamList until the subscription receives them.
+var listChunks sync.Map
+
+func newListChunkKey[T any](ch uintptr, items []T) (listChunkKey, bool) {
+	if len(items) == 0 {
+		return listChunkKey{}, false
+	}
+	return listChunkKey{ch: ch, first: &items[0], len: len(items)}, true
+}
+
+// StreamList sends items to the channel of a subscription resolver in chunks of at most size
+// elements, so a large list reaches the client over several next messages, the first one without
+// waiting for the whole list to be marshaled. A size of 0 or less sends the list in one chunk.
+//
+// Values sent on the channel without StreamList are sent as usual, without the listChunk extension.
+// It returns false if the context was done before every chunk was sent.
+func StreamList[T any](ctx context.Context, ch chan<- []T, items []T, size int) bool {
+	if size <= 0 || size > len(items) {
+		size = len(items)
+	}
+	chPtr := reflect.ValueOf(ch).Pointer()
+
+	for offset := 0; ; offset += size {
+		end := min(offset+size, len(items))
+		chunk := items[offset:end:end]
+		key, ok := newListChunkKey(chPtr, chunk)
+		if ok {
+			listChunks.Store(key, ListChunk{Offset: offset, HasNext: end < len(items)})
+		}
+		select {
+		case ch <- chunk:
+		case <-ctx.Done():
+			if ok {
+				listChunks.Delete(key)
+			}
+			return false
+		}
+		if end == len(items) {
+			return true
+		}
+	}
+}
+
+// RegisterListChunk adds the listChunk extension to the current subscription response if items was
+// sent on ch by StreamList. It is called by the generated subscription resolvers of list fields for
+// each value received.
+func RegisterListChunk[T any](ctx context.Context, ch <-chan []T, items []T) {
+	key, ok := newListChunkKey(reflect.ValueOf(ch).Pointer(), items)
+	if !ok {
+		return
+	}
+	if chunk, ok := listChunks.LoadAndDelete(key); ok {
+		RegisterExtension(ctx, "listChunk", chunk)
+	}
+}
@@ -0,0 +1,77 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamList(t *testing.T) {
+	// receive reads a value from ch and returns the listChunk extension of its response.
+	receive := func(ch <-chan []int) ([]int, any) {
+		v := <-ch
+		ctx := WithResponseContext(context.Background(), DefaultErrorPresenter, nil)
+		RegisterListChunk(ctx, ch, v)
+		return v, GetExtensions(ctx)["listChunk"]
+	}
+
+	t.Run("chunks are matched to their values", func(t *testing.T) {
+		ch := make(chan []int)
+		go StreamList(context.Background(), ch, []int{1, 2, 3}, 2)
+
+		v, chunk := receive(ch)
+		require.Equal(t, []int{1, 2}, v)
+		require.Equal(t, ListChunk{Offset: 0, HasNext: true}, chunk)
+
+		v, chunk = receive(ch)
+		require.Equal(t, []int{3}, v)
+		require.Equal(t, ListChunk{Offset: 2, HasNext: false}, chunk)
+	})
+
+	t.Run("concurrent lists keep their chunks", func(t *testing.T) {
+		ch := make(chan []int)
+		var wg sync.WaitGroup
+		for _, list := range [][]int{{0, 1, 2, 3}, {10, 11, 12, 13}} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				StreamList(context.Background(), ch, list, 1)
+			}()
+		}
+
+		for range 8 {
+			v, chunk := receive(ch)
+			require.Equal(t, v[0]%10, chunk.(ListChunk).Offset, "%v got %v", v, chunk)
+		}
+		wg.Wait()
+	})
+
+	t.Run("values sent without StreamList have no chunk", func(t *testing.T) {
+		ch := make(chan []int)
+		go func() {
+			ch <- []int{1}
+			StreamList(context.Background(), ch, []int{2, 3}, 1)
+			ch <- []int{4}
+		}()
+
+		for _, want := range []any{nil, ListChunk{Offset: 0, HasNext: true}, ListChunk{Offset: 1, HasNext: false}, nil} {
+			_, chunk := receive(ch)
+			require.Equal(t, want, chunk)
+		}
+	})
+
+	t.Run("unsent chunks are not added to later responses", func(t *testing.T) {
+		ch := make(chan []int)
+		items := []int{1, 2}
+		cancelled, cancel := context.WithCancel(context.Background())
+		cancel()
+		require.False(t, StreamList(cancelled, ch, items, 1))
+
+		go func() { ch <- items[:1:1] }()
+		v, chunk := receive(ch)
+		require.Equal(t, []int{1}, v)
+		require.Nil(t, chunk)
+	})
+}
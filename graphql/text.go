@@ -0,0 +1,34 @@
+package graphql
+
+import (
+	"context"
+	"encoding"
+	"fmt"
+	"io"
+)
+
+// MarshalText marshals a value implementing encoding.TextMarshaler as a string, it is used by the
+// generated code of scalars bound to such types.
+func MarshalText(v encoding.TextMarshaler) ContextMarshaler {
+	return ContextWriterFunc(func(ctx context.Context, w io.Writer) error {
+		b, err := v.MarshalText()
+		if err != nil {
+			return err
+		}
+		writeQuotedString(w, string(b))
+		return nil
+	})
+}
+
+// UnmarshalText unmarshals a string into a value implementing encoding.TextUnmarshaler, it is used by
+// the generated code of scalars bound to such types.
+func UnmarshalText(v any, into encoding.TextUnmarshaler) error {
+	switch v := v.(type) {
+	case string:
+		return into.UnmarshalText([]byte(v))
+	case []byte:
+		return into.UnmarshalText(v)
+	default:
+		return fmt.Errorf("%T is not a string", v)
+	}
+}
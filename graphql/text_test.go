@@ -0,0 +1,40 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type failingText struct{}
+
+func (failingText) MarshalText() ([]byte, error) {
+	return nil, errors.New("cannot marshal")
+}
+
+func TestMarshalText(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, MarshalText(netip.MustParseAddr("192.0.2.1")).MarshalGQLContext(context.Background(), &buf))
+	assert.Equal(t, `"192.0.2.1"`, buf.String())
+
+	buf.Reset()
+	require.EqualError(t, MarshalText(failingText{}).MarshalGQLContext(context.Background(), &buf), "cannot marshal")
+	assert.Empty(t, buf.String())
+}
+
+func TestUnmarshalText(t *testing.T) {
+	var addr netip.Addr
+	require.NoError(t, UnmarshalText("2001:db8::1", &addr))
+	assert.Equal(t, netip.MustParseAddr("2001:db8::1"), addr)
+
+	require.NoError(t, UnmarshalText([]byte("192.0.2.1"), &addr))
+	assert.Equal(t, netip.MustParseAddr("192.0.2.1"), addr)
+
+	require.ErrorContains(t, UnmarshalText("not an address", &addr), "ParseAddr")
+	require.EqualError(t, UnmarshalText(123, &addr), "int is not a string")
+}
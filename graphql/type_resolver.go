@@ -0,0 +1,64 @@
+package graphql
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// TypeResolverFunc returns the name of the concrete object type backing obj.
+type TypeResolverFunc func(obj any) string
+
+var (
+	typeResolversMu sync.RWMutex
+	typeResolvers   = map[string]TypeResolverFunc{}
+)
+
+// RegisterTypeResolver registers a runtime resolver for the interface or union named abstractName.
+// Generated code consults it for values whose go type is bound to several of the possible types of
+// abstractName, eg. the objects of a dynamic server, as the type switch can't tell them apart.
+// Registering a nil func removes any resolver previously registered for abstractName.
+func RegisterTypeResolver(abstractName string, f TypeResolverFunc) {
+	typeResolversMu.Lock()
+	defer typeResolversMu.Unlock()
+
+	if f == nil {
+		delete(typeResolvers, abstractName)
+		return
+	}
+	typeResolvers[abstractName] = f
+}
+
+// GetTypeResolver returns the resolver registered for abstractName, if any.
+func GetTypeResolver(abstractName string) (TypeResolverFunc, bool) {
+	typeResolversMu.RLock()
+	defer typeResolversMu.RUnlock()
+
+	f, ok := typeResolvers[abstractName]
+	return f, ok
+}
+
+// ResolveAbstractType resolves obj to one of the possible object types of the abstract type
+// abstractName using the registered type resolver. An error is returned when no resolver is
+// registered, or when the resolver names a type that does not implement the abstract type.
+func ResolveAbstractType(schema *ast.Schema, abstractName string, obj any) (*ast.Definition, error) {
+	def := schema.Types[abstractName]
+	if def == nil || !def.IsAbstractType() {
+		return nil, fmt.Errorf("%s is not an abstract type", abstractName)
+	}
+
+	f, ok := GetTypeResolver(abstractName)
+	if !ok {
+		return nil, fmt.Errorf("no type resolver registered for %s", abstractName)
+	}
+
+	typeName := f(obj)
+	for _, possible := range schema.GetPossibleTypes(def) {
+		if possible.Name == typeName {
+			return possible, nil
+		}
+	}
+
+	return nil, fmt.Errorf("type resolver for %s returned %q which is not a possible type", abstractName, typeName)
+}
@@ -0,0 +1,50 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestResolveAbstractType(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		type Query { search: [SearchResult!]! }
+		type User { name: String! }
+		type Post { title: String! }
+		union SearchResult = User | Post
+	`})
+
+	t.Run("no resolver registered", func(t *testing.T) {
+		_, err := ResolveAbstractType(schema, "SearchResult", map[string]any{})
+		require.EqualError(t, err, "no type resolver registered for SearchResult")
+	})
+
+	RegisterTypeResolver("SearchResult", func(obj any) string {
+		return obj.(map[string]any)["__typename"].(string)
+	})
+	defer RegisterTypeResolver("SearchResult", nil)
+
+	t.Run("resolves a union member", func(t *testing.T) {
+		def, err := ResolveAbstractType(schema, "SearchResult", map[string]any{"__typename": "Post"})
+		require.NoError(t, err)
+		require.Equal(t, "Post", def.Name)
+	})
+
+	t.Run("rejects a type outside the union", func(t *testing.T) {
+		_, err := ResolveAbstractType(schema, "SearchResult", map[string]any{"__typename": "Query"})
+		require.EqualError(t, err, `type resolver for SearchResult returned "Query" which is not a possible type`)
+	})
+
+	t.Run("rejects concrete types", func(t *testing.T) {
+		_, err := ResolveAbstractType(schema, "User", map[string]any{"__typename": "User"})
+		require.EqualError(t, err, "User is not an abstract type")
+	})
+
+	t.Run("nil unregisters", func(t *testing.T) {
+		RegisterTypeResolver("SearchResult", nil)
+		_, ok := GetTypeResolver("SearchResult")
+		require.False(t, ok)
+	})
+}
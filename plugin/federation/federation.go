@@ -109,12 +109,13 @@ func (f *Federation) MutateConfig(cfg *config.Config) error {
 	cfg.Directives[dirNameKey] = config.DirectiveConfig{SkipRuntime: true}
 	cfg.Directives["extends"] = config.DirectiveConfig{SkipRuntime: true}
 	cfg.Directives[dirNameEntityResolver] = config.DirectiveConfig{SkipRuntime: true}
+	// @tag is only read by contracts from the _service SDL, with federation 1 it is defined by the schema
+	cfg.Directives["tag"] = config.DirectiveConfig{SkipRuntime: true}
 
 	// Federation 2 specific directives
 	if f.version == 2 {
 		cfg.Directives["shareable"] = config.DirectiveConfig{SkipRuntime: true}
 		cfg.Directives["link"] = config.DirectiveConfig{SkipRuntime: true}
-		cfg.Directives["tag"] = config.DirectiveConfig{SkipRuntime: true}
 		cfg.Directives["override"] = config.DirectiveConfig{SkipRuntime: true}
 		cfg.Directives["inaccessible"] = config.DirectiveConfig{SkipRuntime: true}
 		cfg.Directives["authenticated"] = config.DirectiveConfig{SkipRuntime: true}
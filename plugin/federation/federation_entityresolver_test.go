@@ -12,6 +12,7 @@ import (
 
 	"github.com/99designs/gqlgen/client"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/plugin/federation/testdata/entityresolver"
 	"github.com/99designs/gqlgen/plugin/federation/testdata/entityresolver/generated"
 )
@@ -109,6 +110,31 @@ func TestEntityResolver(t *testing.T) {
 		require.Empty(t, resp.Entities[4].Name)
 	})
 
+	t.Run("HelloWithError entities report errors at their index", func(t *testing.T) {
+		representations := []map[string]any{
+			{
+				"__typename": "HelloWithErrors",
+				"name":       "first name - 1",
+			}, {
+				"__typename": "HelloWithErrors",
+				"name":       "inject error",
+			},
+		}
+
+		resp, err := c.RawPost(
+			entityQuery([]string{
+				"HelloWithErrors {name}",
+			}),
+			client.Var("representations", representations),
+		)
+
+		require.NoError(t, err)
+		data, err := json.Marshal(resp.Data)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"_entities":[{"name":"first name - 1"},null]}`, string(data))
+		require.JSONEq(t, `[{"message":"resolving Entity \"HelloWithErrors\": error resolving HelloWithErrorsByName","path":["_entities",1]}]`, string(resp.Errors))
+	})
+
 	t.Run("World entities with nested key", func(t *testing.T) {
 		representations := []map[string]any{
 			{
@@ -393,6 +419,28 @@ func TestEntityResolver(t *testing.T) {
 	})
 }
 
+func TestEntityResolverServiceSDL(t *testing.T) {
+	srv := handler.New(
+		generated.NewExecutableSchema(generated.Config{
+			Resolvers: &entityresolver.Resolver{},
+		}),
+	)
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.Introspection{})
+	c := client.New(srv)
+
+	var resp struct {
+		Service struct {
+			SDL string `json:"sdl"`
+		} `json:"_service"`
+	}
+	c.MustPost(`{ _service { sdl } }`, &resp)
+
+	require.Contains(t, resp.Service.SDL, "directive @tag(name: String!) repeatable on")
+	require.Contains(t, resp.Service.SDL, `type Hello @key(fields: "name") @tag(name: "public") {`)
+	require.Contains(t, resp.Service.SDL, `secondary: String! @tag(name: "internal")`)
+}
+
 func TestMultiEntityResolver(t *testing.T) {
 	srv := handler.New(
 		generated.NewExecutableSchema(generated.Config{
@@ -641,8 +689,8 @@ func entityQuery(queries []string) string {
 }
 
 type entityResolverError struct {
-	Message string   `json:"message"`
-	Path    []string `json:"path"`
+	Message string `json:"message"`
+	Path    []any  `json:"path"`
 }
 
 func getEntityErrors(err error) ([]*entityResolverError, error) {
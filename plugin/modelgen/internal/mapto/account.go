@@ -0,0 +1,8 @@
+package mapto
+
+// Account is a model of another package bound to the schema by the tests.
+type Account struct {
+	Name  string
+	Email *string
+	Roles []string
+}
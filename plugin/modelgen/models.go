@@ -40,6 +40,17 @@ type ModelBuild struct {
 	Models      []*Object
 	Enums       []*Enum
 	Scalars     []string
+	// Markers are the interface checks of bound models declared in the models package.
+	Markers []*Marker
+}
+
+func (b *ModelBuild) model(name string) *Object {
+	for _, model := range b.Models {
+		if model.Name == name {
+			return model
+		}
+	}
+	return nil
 }
 
 type Interface struct {
@@ -56,6 +67,35 @@ type Object struct {
 	Name        string
 	Fields      []*Field
 	Implements  []string
+	// Mappers are the To<Model>() methods of an input type, configured with mapTo.
+	Mappers []*Mapper
+}
+
+// Mapper copies the fields an input type shares with a model into a new instance of the model.
+type Mapper struct {
+	// Name is the model's name as it appears in the schema
+	Name string
+	// Type is the Go type of the model
+	Type   types.Type
+	Fields []*MapperField
+}
+
+// Marker is the interface check of a bound model, eg. IsNode(), for a type declared in the models
+// package that doesn't implement it yet. Types of other packages can't be given methods.
+type Marker struct {
+	// Type is the Go type of the model
+	Type types.Type
+	// Interface is the interface's name as it appears in the schema
+	Interface string
+}
+
+type MapperField struct {
+	GoName string
+	// Deref is true when the input field is a pointer to the type of the model field, in which case
+	// it is only copied when it is set.
+	Deref bool
+	// Ref is true when the model field is a pointer to the type of the input field.
+	Ref bool
 }
 
 type Field struct {
@@ -74,11 +114,18 @@ type Enum struct {
 	Description string
 	Name        string
 	Values      []*EnumValue
+	// IntBacked is true when every value declares its numeric value with @enumValue(int: N), in
+	// which case the enum is generated as an int type instead of a string.
+	IntBacked bool
+	// CaseInsensitive is true when the enum accepts input values in any case.
+	CaseInsensitive bool
 }
 
 type EnumValue struct {
 	Description string
 	Name        string
+	// Int is the value given by the @enumValue directive, only set for int backed enums.
+	Int int64
 }
 
 func New() plugin.Plugin {
@@ -148,6 +195,7 @@ func (m *Plugin) MutateConfig(cfg *config.Config) error {
 			if err != nil {
 				return err
 			}
+			sortFields(cfg.ModelFieldOrder, fields)
 
 			it := &Object{
 				Description: schemaType.Description,
@@ -176,8 +224,9 @@ func (m *Plugin) MutateConfig(cfg *config.Config) error {
 			b.Models = append(b.Models, it)
 		case ast.Enum:
 			it := &Enum{
-				Name:        schemaType.Name,
-				Description: schemaType.Description,
+				Name:            schemaType.Name,
+				Description:     schemaType.Description,
+				CaseInsensitive: cfg.CaseInsensitiveEnums,
 			}
 
 			for _, v := range schemaType.EnumValues {
@@ -187,6 +236,10 @@ func (m *Plugin) MutateConfig(cfg *config.Config) error {
 				})
 			}
 
+			if err := bindEnumIntValues(schemaType, it); err != nil {
+				return err
+			}
+
 			b.Enums = append(b.Enums, it)
 		case ast.Scalar:
 			b.Scalars = append(b.Scalars, schemaType.Name)
@@ -235,6 +288,13 @@ func (m *Plugin) MutateConfig(cfg *config.Config) error {
 		b = m.MutateHook(b)
 	}
 
+	if err := bindMappers(cfg, b); err != nil {
+		return err
+	}
+	if err := bindMarkers(cfg, b); err != nil {
+		return err
+	}
+
 	getInterfaceByName := func(name string) *Interface {
 		// Allow looking up interfaces, so template can generate getters for each field
 		for _, i := range b.Interfaces {
@@ -302,6 +362,9 @@ func (m *Plugin) MutateConfig(cfg *config.Config) error {
 	funcMap := template.FuncMap{
 		"getInterfaceByName": getInterfaceByName,
 		"generateGetter":     generateGetter,
+		"interfaceCheck": func(name string) string {
+			return interfaceCheck(cfg, name)
+		},
 	}
 	newModelTemplate := modelTemplate
 	if cfg.Model.ModelTemplate != "" {
@@ -422,13 +485,18 @@ func (m *Plugin) generateField(
 		typ = buildType(userDefinedType)
 	}
 
+	omittable := cfg.NullableInputOmittable
+	if typeOmittable := cfg.Models[schemaType.Name].Omittable; typeOmittable != nil {
+		omittable = *typeOmittable
+	}
+
 	f := &Field{
 		Name:        field.Name,
 		GoName:      name,
 		Type:        typ,
 		Description: field.Description,
 		Tag:         getStructTagFromField(cfg, field),
-		Omittable:   cfg.NullableInputOmittable && schemaType.Kind == ast.InputObject && !field.Type.NonNull,
+		Omittable:   omittable && schemaType.Kind == ast.InputObject && !field.Type.NonNull,
 		IsResolver:  cfg.Models[schemaType.Name].Fields[field.Name].Resolver,
 	}
 
@@ -525,6 +593,196 @@ func getExtraFields(cfg *config.Config, modelName string) []*Field {
 	return extraFields
 }
 
+// bindMappers adds the mappers configured with mapTo to the input types, after the build was mutated
+// so they copy the final fields.
+func bindMappers(cfg *config.Config, b *ModelBuild) error {
+	for _, model := range b.Models {
+		targets := cfg.Models[model.Name].MapTo
+		if len(targets) == 0 {
+			continue
+		}
+		if def := cfg.Schema.Types[model.Name]; def == nil || def.Kind != ast.InputObject {
+			return fmt.Errorf("%s: mapTo is only supported on input types", model.Name)
+		}
+
+		for _, target := range targets {
+			mapper, err := newMapper(cfg, b, model, target)
+			if err != nil {
+				return fmt.Errorf("%s: %w", model.Name, err)
+			}
+			model.Mappers = append(model.Mappers, mapper)
+		}
+	}
+	return nil
+}
+
+func bindMarkers(cfg *config.Config, b *ModelBuild) error {
+	if cfg.OmitInterfaceChecks {
+		return nil
+	}
+	generated := map[string]bool{}
+	for _, it := range b.Interfaces {
+		generated[it.Name] = !it.OmitCheck
+	}
+
+	var binder *config.Binder
+	for _, def := range cfg.Schema.Types {
+		if def.Kind != ast.Object || cfg.IsRoot(def) || b.model(def.Name) != nil || !cfg.Models.UserDefined(def.Name) {
+			continue
+		}
+
+		var ifaces []string
+		seen := map[string]bool{}
+		for _, implementor := range cfg.Schema.GetImplements(def) {
+			for _, iface := range append([]string{implementor.Name}, implementor.Interfaces...) {
+				if generated[iface] && !seen[iface] {
+					ifaces = append(ifaces, iface)
+					seen[iface] = true
+				}
+			}
+		}
+		if len(ifaces) == 0 {
+			continue
+		}
+
+		if binder == nil {
+			binder = cfg.NewBinder()
+		}
+		for _, model := range cfg.Models[def.Name].Model {
+			t, err := binder.FindTypeFromName(model)
+			if err != nil {
+				return fmt.Errorf("%s: %w", def.Name, err)
+			}
+			named, ok := t.(*types.Named)
+			if !ok || named.Obj().Pkg() == nil || named.Obj().Pkg().Path() != cfg.Model.ImportPath() {
+				continue
+			}
+			if types.IsInterface(named) || named.TypeParams().Len() > 0 {
+				continue
+			}
+			if _, ok := named.Underlying().(*types.Pointer); ok {
+				continue
+			}
+			for _, iface := range ifaces {
+				if !hasMarker(cfg, named, iface) {
+					b.Markers = append(b.Markers, &Marker{Type: named, Interface: iface})
+				}
+			}
+		}
+	}
+
+	sort.Slice(b.Markers, func(i, j int) bool {
+		ti, tj := b.Markers[i].Type.(*types.Named).Obj().Name(), b.Markers[j].Type.(*types.Named).Obj().Name()
+		if ti != tj {
+			return ti < tj
+		}
+		return b.Markers[i].Interface < b.Markers[j].Interface
+	})
+	return nil
+}
+
+// interfaceCheck returns the name of the marker method of the interface or union name.
+func interfaceCheck(cfg *config.Config, name string) string {
+	// the federation runtime expects entities to implement IsEntity()
+	if name == "_Entity" {
+		return "IsEntity"
+	}
+	prefix := cfg.InterfaceCheckPrefix
+	if prefix == "" {
+		prefix = "Is"
+	}
+	return prefix + templates.ToGoModelName(name)
+}
+
+// hasMarker reports whether named already implements the interface check of iface, ignoring the one
+// generated in the models file by a previous run.
+func hasMarker(cfg *config.Config, named *types.Named, iface string) bool {
+	obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(named), true, named.Obj().Pkg(), interfaceCheck(cfg, iface))
+	if obj == nil {
+		return false
+	}
+	pkg := cfg.Packages.Load(named.Obj().Pkg().Path())
+	return pkg == nil || pkg.Fset.Position(obj.Pos()).Filename != cfg.Model.Filename
+}
+
+func newMapper(cfg *config.Config, b *ModelBuild, input *Object, target string) (*Mapper, error) {
+	if def := cfg.Schema.Types[target]; def == nil || def.Kind != ast.Object {
+		return nil, fmt.Errorf("mapTo %s must be an object type", target)
+	}
+
+	mapper := &Mapper{Name: target}
+	targetFields := map[string]types.Type{}
+	if model := b.model(target); model != nil {
+		for _, f := range model.Fields {
+			if f.Name != "" {
+				targetFields[f.GoName] = f.Type
+			}
+		}
+		// the model is generated in the same file, so it can't be loaded yet
+		pkg := types.NewPackage(cfg.Model.ImportPath(), cfg.Model.Package)
+		mapper.Type = types.NewNamed(types.NewTypeName(0, pkg, templates.ToGo(target), nil), types.NewStruct(nil, nil), nil)
+	} else {
+		t, err := cfg.NewBinder().FindTypeFromName(cfg.Models[target].Model[0])
+		if err != nil {
+			return nil, fmt.Errorf("mapTo %s: %w", target, err)
+		}
+		st, ok := t.Underlying().(*types.Struct)
+		if !ok {
+			return nil, fmt.Errorf("mapTo %s must be bound to a struct", target)
+		}
+		for i := 0; i < st.NumFields(); i++ {
+			if f := st.Field(i); f.Exported() && !f.Embedded() {
+				targetFields[f.Name()] = f.Type()
+			}
+		}
+		mapper.Type = t
+	}
+
+	for _, f := range input.Fields {
+		t, ok := targetFields[f.GoName]
+		if f.Name == "" || !ok {
+			continue
+		}
+		if sameType(f.Type, t) {
+			mapper.Fields = append(mapper.Fields, &MapperField{GoName: f.GoName})
+		} else if p, ok := f.Type.(*types.Pointer); ok && sameType(p.Elem(), t) {
+			mapper.Fields = append(mapper.Fields, &MapperField{GoName: f.GoName, Deref: true})
+		} else if p, ok := t.(*types.Pointer); ok && sameType(f.Type, p.Elem()) {
+			mapper.Fields = append(mapper.Fields, &MapperField{GoName: f.GoName, Ref: true})
+		}
+	}
+
+	return mapper, nil
+}
+
+// sameType compares types by name, as the types of generated models are created for each field
+// that references them.
+func sameType(a, b types.Type) bool {
+	return types.TypeString(a, nil) == types.TypeString(b, nil)
+}
+
+// fieldSizes are the sizes used to order fields by size, they are fixed so the generated code doesn't
+// depend on the architecture it was generated on.
+var fieldSizes = types.SizesFor("gc", "amd64")
+
+// sortFields orders the fields of a model struct as configured by model_field_order.
+func sortFields(order config.ModelFieldOrder, fields []*Field) {
+	switch order {
+	case config.ModelFieldOrderAlphabetical:
+		sort.SliceStable(fields, func(i, j int) bool {
+			return fields[i].GoName < fields[j].GoName
+		})
+	case config.ModelFieldOrderSize:
+		sort.SliceStable(fields, func(i, j int) bool {
+			ai, aj := fieldSizes.Alignof(fields[i].Type), fieldSizes.Alignof(fields[j].Type)
+			if ai != aj {
+				return ai > aj
+			}
+			return fieldSizes.Sizeof(fields[i].Type) > fieldSizes.Sizeof(fields[j].Type)
+		})
+	}
+}
+
 func getStructTagFromField(cfg *config.Config, field *ast.FieldDefinition) string {
 	var tags []string
 
@@ -733,3 +991,41 @@ func readModelTemplate(customModelTemplate string) string {
 	}
 	return string(contentBytes)
 }
+
+// bindEnumIntValues reads the @enumValue(int: N) directives on the values of an enum. Either all
+// values or none of them must carry the directive, and the numbers must be unique.
+func bindEnumIntValues(schemaType *ast.Definition, it *Enum) error {
+	seen := map[int64]string{}
+	for i, v := range schemaType.EnumValues {
+		d := v.Directives.ForName("enumValue")
+		if d == nil {
+			if it.IntBacked {
+				return fmt.Errorf("enum %s: value %s is missing an @enumValue directive", schemaType.Name, v.Name)
+			}
+			continue
+		}
+		if i > 0 && !it.IntBacked {
+			return fmt.Errorf("enum %s: value %s is missing an @enumValue directive", schemaType.Name, schemaType.EnumValues[0].Name)
+		}
+		it.IntBacked = true
+
+		arg := d.Arguments.ForName("int")
+		if arg == nil {
+			return fmt.Errorf("enum %s: @enumValue on %s requires an int argument", schemaType.Name, v.Name)
+		}
+		value, err := arg.Value.Value(nil)
+		if err != nil {
+			return fmt.Errorf("enum %s: @enumValue on %s: %w", schemaType.Name, v.Name, err)
+		}
+		n, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("enum %s: @enumValue on %s must be an int", schemaType.Name, v.Name)
+		}
+		if other, ok := seen[n]; ok {
+			return fmt.Errorf("enum %s: values %s and %s both use @enumValue(int: %d)", schemaType.Name, other, v.Name, n)
+		}
+		seen[n] = v.Name
+		it.Values[i].Int = n
+	}
+	return nil
+}
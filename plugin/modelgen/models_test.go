@@ -1,6 +1,7 @@
 package modelgen
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"go/ast"
@@ -16,11 +17,15 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	gqlast "github.com/vektah/gqlparser/v2/ast"
 
 	"github.com/99designs/gqlgen/codegen/config"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/plugin/modelgen/internal/extrafields"
+	"github.com/99designs/gqlgen/plugin/modelgen/internal/mapto"
 	"github.com/99designs/gqlgen/plugin/modelgen/out"
+	"github.com/99designs/gqlgen/plugin/modelgen/out_case_insensitive_enums"
 	"github.com/99designs/gqlgen/plugin/modelgen/out_enable_model_json_omitempty_tag_false"
 	"github.com/99designs/gqlgen/plugin/modelgen/out_enable_model_json_omitempty_tag_false_omitzero_tag_false"
 	"github.com/99designs/gqlgen/plugin/modelgen/out_enable_model_json_omitempty_tag_false_omitzero_tag_nil"
@@ -30,6 +35,9 @@ import (
 	"github.com/99designs/gqlgen/plugin/modelgen/out_enable_model_json_omitzero_tag_false"
 	"github.com/99designs/gqlgen/plugin/modelgen/out_enable_model_json_omitzero_tag_nil"
 	"github.com/99designs/gqlgen/plugin/modelgen/out_enable_model_json_omitzero_tag_true"
+	"github.com/99designs/gqlgen/plugin/modelgen/out_input_type_omittable"
+	"github.com/99designs/gqlgen/plugin/modelgen/out_interface_checks"
+	"github.com/99designs/gqlgen/plugin/modelgen/out_map_to"
 	"github.com/99designs/gqlgen/plugin/modelgen/out_nullable_input_omittable"
 	"github.com/99designs/gqlgen/plugin/modelgen/out_struct_pointers"
 )
@@ -337,6 +345,199 @@ func TestModelGenerationOmitResolverFields(t *testing.T) {
 	require.NotContains(t, string(generated), "ResolverField")
 }
 
+func TestModelGenerationFieldOrder(t *testing.T) {
+	suites := []struct {
+		order    string
+		expected string
+	}{
+		{
+			order: "schema",
+			expected: `type MixedSizes struct {
+	Flag    bool     ` + "`json:\"flag\"`" + `
+	Name    string   ` + "`json:\"name\"`" + `
+	Count   int      ` + "`json:\"count\"`" + `
+	Enabled *bool    ` + "`json:\"enabled,omitempty\"`" + `
+	Ratio   float64  ` + "`json:\"ratio\"`" + `
+	Tags    []string ` + "`json:\"tags\"`" + `
+}`,
+		},
+		{
+			order: "alphabetical",
+			expected: `type MixedSizes struct {
+	Count   int      ` + "`json:\"count\"`" + `
+	Enabled *bool    ` + "`json:\"enabled,omitempty\"`" + `
+	Flag    bool     ` + "`json:\"flag\"`" + `
+	Name    string   ` + "`json:\"name\"`" + `
+	Ratio   float64  ` + "`json:\"ratio\"`" + `
+	Tags    []string ` + "`json:\"tags\"`" + `
+}`,
+		},
+		{
+			order: "size",
+			expected: `type MixedSizes struct {
+	Tags    []string ` + "`json:\"tags\"`" + `
+	Name    string   ` + "`json:\"name\"`" + `
+	Count   int      ` + "`json:\"count\"`" + `
+	Enabled *bool    ` + "`json:\"enabled,omitempty\"`" + `
+	Ratio   float64  ` + "`json:\"ratio\"`" + `
+	Flag    bool     ` + "`json:\"flag\"`" + `
+}`,
+		},
+	}
+
+	for _, s := range suites {
+		t.Run(s.order, func(t *testing.T) {
+			cfg, err := config.LoadConfig("testdata/gqlgen_model_field_order_" + s.order + ".yml")
+			require.NoError(t, err)
+			require.NoError(t, cfg.Init())
+			p := New().(*Plugin)
+			require.NoError(t, p.MutateConfig(cfg))
+			require.NoError(t, goBuild(t, "./out_model_field_order_"+s.order+"/"))
+			generated, err := os.ReadFile("./out_model_field_order_" + s.order + "/generated.go")
+			require.NoError(t, err)
+			require.Contains(t, string(generated), s.expected)
+		})
+	}
+}
+
+func TestModelGenerationMapTo(t *testing.T) {
+	cfg, err := config.LoadConfig("testdata/gqlgen_map_to.yml")
+	require.NoError(t, err)
+	require.NoError(t, cfg.Init())
+	p := New().(*Plugin)
+	require.NoError(t, p.MutateConfig(cfg))
+	require.NoError(t, goBuild(t, "./out_map_to/"))
+	generated, err := os.ReadFile("./out_map_to/generated.go")
+	require.NoError(t, err)
+	require.Contains(t, string(generated), `// ToUser returns a new User with the fields it shares with CreateUserInput.
+func (this CreateUserInput) ToUser() *User {
+	res := &User{}
+	res.Name = this.Name
+	if this.Email != nil {
+		res.Email = *this.Email
+	}
+	res.Age = &this.Age
+	return res
+}`)
+	require.Contains(t, string(generated), `func (this CreateUserInput) ToAccount() *mapto.Account {`)
+
+	t.Run("copies the shared fields", func(t *testing.T) {
+		email := "alice@example.com"
+		in := out_map_to.CreateUserInput{Name: "Alice", Email: &email, Age: 30, Password: "secret"}
+
+		user := in.ToUser()
+		require.Equal(t, &out_map_to.User{Name: "Alice", Email: "alice@example.com", Age: &in.Age}, user)
+		require.Equal(t, 30, *user.Age)
+		require.Empty(t, user.ID)
+
+		require.Equal(t, &mapto.Account{Name: "Alice", Email: &email}, in.ToAccount())
+	})
+
+	t.Run("leaves unset fields empty", func(t *testing.T) {
+		user := out_map_to.CreateUserInput{Name: "Bob"}.ToUser()
+		require.Equal(t, "Bob", user.Name)
+		require.Empty(t, user.Email)
+	})
+}
+
+func TestModelGenerationInterfaceChecks(t *testing.T) {
+	cfg, err := config.LoadConfig("testdata/gqlgen_interface_checks.yml")
+	require.NoError(t, err)
+	require.NoError(t, cfg.Init())
+	p := New().(*Plugin)
+	require.NoError(t, p.MutateConfig(cfg))
+	require.NoError(t, goBuild(t, "./out_interface_checks/"))
+	generated, err := os.ReadFile("./out_interface_checks/generated.go")
+	require.NoError(t, err)
+
+	t.Run("interfaces use the prefix", func(t *testing.T) {
+		require.Contains(t, string(generated), `type Content interface {
+	ImplementsNode()
+	ImplementsContent()`)
+		require.Contains(t, string(generated), `type SearchResult interface {
+	ImplementsSearchResult()
+}`)
+	})
+
+	t.Run("generated models implement their interfaces", func(t *testing.T) {
+		require.Contains(t, string(generated), `func (User) ImplementsNode()`)
+		require.Contains(t, string(generated), `func (User) ImplementsSearchResult()`)
+	})
+
+	t.Run("bound models of the package get the missing markers", func(t *testing.T) {
+		require.Contains(t, string(generated), `func (Post) ImplementsContent()`)
+		require.Contains(t, string(generated), `func (Post) ImplementsSearchResult()`)
+		require.NotContains(t, string(generated), `func (Post) ImplementsNode()`)
+	})
+
+	t.Run("bound models of other packages are left alone", func(t *testing.T) {
+		require.NotContains(t, string(generated), `Account)`)
+	})
+
+	t.Run("every implementer satisfies its interfaces", func(t *testing.T) {
+		var _ out_interface_checks.Node = out_interface_checks.User{}
+		var _ out_interface_checks.Node = out_interface_checks.Post{}
+		var _ out_interface_checks.Content = out_interface_checks.Post{}
+		var _ out_interface_checks.SearchResult = out_interface_checks.User{}
+		var _ out_interface_checks.SearchResult = out_interface_checks.Post{}
+	})
+}
+
+func TestModelGenerationCaseInsensitiveEnums(t *testing.T) {
+	cfg, err := config.LoadConfig("testdata/gqlgen_case_insensitive_enums.yml")
+	require.NoError(t, err)
+	require.NoError(t, cfg.Init())
+	p := New().(*Plugin)
+	require.NoError(t, p.MutateConfig(cfg))
+	require.NoError(t, goBuild(t, "./out_case_insensitive_enums/"))
+	generated, err := os.ReadFile("./out_case_insensitive_enums/generated.go")
+	require.NoError(t, err)
+	require.Contains(t, string(generated), `	for _, value := range AllStatus {
+		if strings.EqualFold(str, value.String()) {
+			*e = value
+			return nil
+		}
+	}
+	return fmt.Errorf("%s is not a valid Status", str)`)
+
+	t.Run("accepts values in any case", func(t *testing.T) {
+		for input, expected := range map[string]out_case_insensitive_enums.Status{
+			"ACTIVE":  out_case_insensitive_enums.StatusActive,
+			"active":  out_case_insensitive_enums.StatusActive,
+			"On_Hold": out_case_insensitive_enums.StatusOnHold,
+			"DONE":    out_case_insensitive_enums.StatusDone,
+		} {
+			var status out_case_insensitive_enums.Status
+			require.NoError(t, status.UnmarshalGQL(input), input)
+			require.Equal(t, expected, status, input)
+		}
+
+		var priority out_case_insensitive_enums.Priority
+		require.NoError(t, priority.UnmarshalGQL("high"))
+		require.Equal(t, out_case_insensitive_enums.PriorityHigh, priority)
+	})
+
+	t.Run("returns values in their canonical case", func(t *testing.T) {
+		var status out_case_insensitive_enums.Status
+		require.NoError(t, json.Unmarshal([]byte(`"done"`), &status))
+		b, err := json.Marshal(status)
+		require.NoError(t, err)
+		require.JSONEq(t, `"Done"`, string(b))
+
+		var priority out_case_insensitive_enums.Priority
+		require.NoError(t, priority.UnmarshalGQL("Low"))
+		require.Equal(t, "LOW", priority.String())
+	})
+
+	t.Run("rejects unknown values", func(t *testing.T) {
+		var status out_case_insensitive_enums.Status
+		require.EqualError(t, status.UnmarshalGQL("paused"), "paused is not a valid Status")
+
+		var priority out_case_insensitive_enums.Priority
+		require.EqualError(t, priority.UnmarshalGQL("medium"), "medium is not a valid Priority")
+	})
+}
+
 func TestModelGenerationStructFieldPointers(t *testing.T) {
 	cfg, err := config.LoadConfig("testdata/gqlgen_struct_field_pointers.yml")
 	require.NoError(t, err)
@@ -407,6 +608,27 @@ func TestModelGenerationNullableInputOmittable(t *testing.T) {
 	})
 }
 
+func TestModelGenerationInputTypeOmittable(t *testing.T) {
+	cfg, err := config.LoadConfig("testdata/gqlgen_input_type_omittable.yml")
+	require.NoError(t, err)
+	require.NoError(t, cfg.Init())
+	p := Plugin{
+		MutateHook: mutateHook,
+		FieldHook:  DefaultFieldMutateHook,
+	}
+	require.NoError(t, p.MutateConfig(cfg))
+
+	t.Run("nullable fields of the input type are omittable", func(t *testing.T) {
+		require.IsType(t, graphql.Omittable[*string]{}, out_input_type_omittable.MissingInput{}.Name)
+		require.IsType(t, graphql.Omittable[*out_input_type_omittable.MissingEnum]{}, out_input_type_omittable.MissingInput{}.Enum)
+		require.IsType(t, graphql.Omittable[*string]{}, out_input_type_omittable.MissingInput{}.NullString)
+	})
+
+	t.Run("non-nullable fields of the input type are not omittable", func(t *testing.T) {
+		require.IsType(t, "", out_input_type_omittable.MissingInput{}.NonNullString)
+	})
+}
+
 func TestModelGenerationOmitemptyConfig(t *testing.T) {
 	suites := []struct {
 		n       string
@@ -784,3 +1006,45 @@ func TestCustomTemplate(t *testing.T) {
 	}
 	require.NoError(t, p.MutateConfig(cfg))
 }
+
+func TestBindEnumIntValues(t *testing.T) {
+	load := func(t *testing.T, enum string) *Enum {
+		schema, err := gqlparser.LoadSchema(&gqlast.Source{Input: `
+			directive @enumValue(int: Int!) on ENUM_VALUE
+			type Query { e: E }
+		` + enum})
+		require.NoError(t, err)
+
+		def := schema.Types["E"]
+		it := &Enum{Name: def.Name}
+		for _, v := range def.EnumValues {
+			it.Values = append(it.Values, &EnumValue{Name: v.Name})
+		}
+		err = bindEnumIntValues(def, it)
+		if err != nil {
+			return &Enum{Description: err.Error()}
+		}
+		return it
+	}
+
+	t.Run("string enum", func(t *testing.T) {
+		it := load(t, `enum E { A B }`)
+		require.False(t, it.IntBacked)
+	})
+
+	t.Run("int enum", func(t *testing.T) {
+		it := load(t, `enum E { A @enumValue(int: 3) B @enumValue(int: 7) }`)
+		require.True(t, it.IntBacked)
+		require.Equal(t, int64(3), it.Values[0].Int)
+		require.Equal(t, int64(7), it.Values[1].Int)
+	})
+
+	t.Run("missing directive", func(t *testing.T) {
+		require.Equal(t, "enum E: value B is missing an @enumValue directive", load(t, `enum E { A @enumValue(int: 3) B }`).Description)
+		require.Equal(t, "enum E: value A is missing an @enumValue directive", load(t, `enum E { A B @enumValue(int: 3) }`).Description)
+	})
+
+	t.Run("duplicate value", func(t *testing.T) {
+		require.Equal(t, "enum E: values A and B both use @enumValue(int: 3)", load(t, `enum E { A @enumValue(int: 3) B @enumValue(int: 3) }`).Description)
+	})
+}
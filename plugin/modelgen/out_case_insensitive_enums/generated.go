@@ -0,0 +1,141 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package out_case_insensitive_enums
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+type Query struct {
+}
+
+type Task struct {
+	Status   Status   `json:"status"`
+	Priority Priority `json:"priority"`
+}
+
+type Priority int
+
+const (
+	PriorityLow  Priority = 1
+	PriorityHigh Priority = 10
+)
+
+var AllPriority = []Priority{
+	PriorityLow,
+	PriorityHigh,
+}
+
+func (e Priority) IsValid() bool {
+	switch e {
+	case PriorityLow, PriorityHigh:
+		return true
+	}
+	return false
+}
+
+func (e Priority) String() string {
+	switch e {
+	case PriorityLow:
+		return "LOW"
+	case PriorityHigh:
+		return "HIGH"
+	}
+	return strconv.Itoa(int(e))
+}
+
+func (e *Priority) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	for _, value := range AllPriority {
+		if strings.EqualFold(str, value.String()) {
+			*e = value
+			return nil
+		}
+	}
+	return fmt.Errorf("%s is not a valid Priority", str)
+}
+
+func (e Priority) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *Priority) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e Priority) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type Status string
+
+const (
+	StatusActive Status = "ACTIVE"
+	StatusOnHold Status = "ON_HOLD"
+	StatusDone   Status = "Done"
+)
+
+var AllStatus = []Status{
+	StatusActive,
+	StatusOnHold,
+	StatusDone,
+}
+
+func (e Status) IsValid() bool {
+	switch e {
+	case StatusActive, StatusOnHold, StatusDone:
+		return true
+	}
+	return false
+}
+
+func (e Status) String() string {
+	return string(e)
+}
+
+func (e *Status) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	for _, value := range AllStatus {
+		if strings.EqualFold(str, value.String()) {
+			*e = value
+			return nil
+		}
+	}
+	return fmt.Errorf("%s is not a valid Status", str)
+}
+
+func (e Status) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *Status) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e Status) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,11 @@
+package out_interface_checks
+
+type Post struct {
+	ID    string
+	Title string
+}
+
+func (Post) ImplementsNode() {}
+
+func (this Post) GetID() string    { return this.ID }
+func (this Post) GetTitle() string { return this.Title }
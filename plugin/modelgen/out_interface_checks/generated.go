@@ -0,0 +1,35 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package out_interface_checks
+
+type Content interface {
+	ImplementsNode()
+	ImplementsContent()
+	GetID() string
+	GetTitle() string
+}
+
+type Node interface {
+	ImplementsNode()
+	GetID() string
+}
+
+type SearchResult interface {
+	ImplementsSearchResult()
+}
+
+type Query struct {
+}
+
+type User struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (User) ImplementsSearchResult() {}
+
+func (User) ImplementsNode()    {}
+func (this User) GetID() string { return this.ID }
+
+func (Post) ImplementsContent()      {}
+func (Post) ImplementsSearchResult() {}
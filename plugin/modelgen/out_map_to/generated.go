@@ -0,0 +1,43 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package out_map_to
+
+import (
+	"github.com/99designs/gqlgen/plugin/modelgen/internal/mapto"
+)
+
+type CreateUserInput struct {
+	Name     string  `json:"name"`
+	Email    *string `json:"email,omitempty"`
+	Age      int     `json:"age"`
+	Password string  `json:"password"`
+}
+
+// ToUser returns a new User with the fields it shares with CreateUserInput.
+func (this CreateUserInput) ToUser() *User {
+	res := &User{}
+	res.Name = this.Name
+	if this.Email != nil {
+		res.Email = *this.Email
+	}
+	res.Age = &this.Age
+	return res
+}
+
+// ToAccount returns a new Account with the fields it shares with CreateUserInput.
+func (this CreateUserInput) ToAccount() *mapto.Account {
+	res := &mapto.Account{}
+	res.Name = this.Name
+	res.Email = this.Email
+	return res
+}
+
+type Query struct {
+}
+
+type User struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	Age   *int   `json:"age,omitempty"`
+}
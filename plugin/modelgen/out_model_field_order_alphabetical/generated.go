@@ -0,0 +1,15 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package out_model_field_order_alphabetical
+
+type MixedSizes struct {
+	Count   int      `json:"count"`
+	Enabled *bool    `json:"enabled,omitempty"`
+	Flag    bool     `json:"flag"`
+	Name    string   `json:"name"`
+	Ratio   float64  `json:"ratio"`
+	Tags    []string `json:"tags"`
+}
+
+type Query struct {
+}
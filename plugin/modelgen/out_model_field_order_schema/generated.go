@@ -0,0 +1,15 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package out_model_field_order_schema
+
+type MixedSizes struct {
+	Flag    bool     `json:"flag"`
+	Name    string   `json:"name"`
+	Count   int      `json:"count"`
+	Enabled *bool    `json:"enabled,omitempty"`
+	Ratio   float64  `json:"ratio"`
+	Tags    []string `json:"tags"`
+}
+
+type Query struct {
+}
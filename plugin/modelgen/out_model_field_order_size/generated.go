@@ -0,0 +1,15 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package out_model_field_order_size
+
+type MixedSizes struct {
+	Tags    []string `json:"tags"`
+	Name    string   `json:"name"`
+	Count   int      `json:"count"`
+	Enabled *bool    `json:"enabled,omitempty"`
+	Ratio   float64  `json:"ratio"`
+	Flag    bool     `json:"flag"`
+}
+
+type Query struct {
+}
@@ -252,6 +252,10 @@ type FooBarr struct {
 func (FooBarr) IsFooBarer()          {}
 func (this FooBarr) GetName() string { return this.Name }
 
+func (ExistingType) IsMissingInterface()     {}
+func (ExistingType) IsMissingUnion()         {}
+func (ExistingType) IsUnionWithDescription() {}
+
 // EnumWithDescription is an enum with a description
 type EnumWithDescription string
 
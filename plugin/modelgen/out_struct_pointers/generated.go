@@ -210,6 +210,10 @@ type FooBarr struct {
 
 func (FooBarr) IsFooBarer() {}
 
+func (ExistingType) IsMissingInterface()     {}
+func (ExistingType) IsMissingUnion()         {}
+func (ExistingType) IsUnionWithDescription() {}
+
 // EnumWithDescription is an enum with a description
 type EnumWithDescription string
 
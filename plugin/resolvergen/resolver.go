@@ -81,10 +81,10 @@ func (m *Plugin) generateSingleFile(data *codegen.Data) error {
 			}
 
 			structName := templates.LcFirst(o.Name) + templates.UcFirst(data.Config.Resolver.Type)
-			comment := strings.TrimSpace(strings.TrimLeft(rewriter.GetMethodComment(structName, f.GoFieldName), `\`))
-			implementation := strings.TrimSpace(rewriter.GetMethodBody(structName, f.GoFieldName))
+			comment := strings.TrimSpace(strings.TrimLeft(rewriter.GetMethodComment(structName, f.GoResolverName), `\`))
+			implementation := strings.TrimSpace(rewriter.GetMethodBody(structName, f.GoResolverName))
 			if implementation != "" {
-				resolver := Resolver{o, f, rewriter.GetPrevDecl(structName, f.GoFieldName), comment, implementation, nil}
+				resolver := Resolver{o, f, rewriter.GetPrevDecl(structName, f.GoResolverName), comment, implementation, nil}
 				file.Resolvers = append(file.Resolvers, &resolver)
 			} else {
 				resolver := Resolver{o, f, nil, "", `panic("not implemented")`, nil}
@@ -160,9 +160,9 @@ func (m *Plugin) generatePerSchema(data *codegen.Data) error {
 			}
 			structName := templates.LcFirst(o.Name) + templates.UcFirst(data.Config.Resolver.Type)
 			// TODO(steve): Why do we need to trimLeft "\" here? Some bazel thing?
-			comment := strings.TrimSpace(strings.TrimLeft(rewriter.GetMethodComment(structName, f.GoFieldName), `\`))
-			implementation := strings.TrimSpace(rewriter.GetMethodBody(structName, f.GoFieldName))
-			resolver := Resolver{o, f, rewriter.GetPrevDecl(structName, f.GoFieldName), comment, implementation, nil}
+			comment := strings.TrimSpace(strings.TrimLeft(rewriter.GetMethodComment(structName, f.GoResolverName), `\`))
+			implementation := strings.TrimSpace(rewriter.GetMethodBody(structName, f.GoResolverName))
+			resolver := Resolver{o, f, rewriter.GetPrevDecl(structName, f.GoResolverName), comment, implementation, nil}
 			var implExists bool
 			for _, p := range data.Plugins {
 				rImpl, ok := p.(plugin.ResolverImplementer)
@@ -300,7 +300,7 @@ func (r *Resolver) Implementation() string {
 	// if not implementation was previously used, use default implementation
 	if r.ImplementationStr == "" {
 		// use default implementation, if no implementation was previously used
-		return fmt.Sprintf("panic(fmt.Errorf(\"not implemented: %v - %v\"))", r.Field.GoFieldName, r.Field.Name)
+		return fmt.Sprintf("panic(fmt.Errorf(\"not implemented: %v - %v\"))", r.Field.GoResolverName, r.Field.Name)
 	}
 	// use previously used implementation
 	return r.ImplementationStr
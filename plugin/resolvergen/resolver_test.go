@@ -81,6 +81,68 @@ func TestLayoutFollowSchemaWithCustomFilename(t *testing.T) {
 	require.Contains(t, source, "// AUserHelperFunction implementation")
 }
 
+func TestResolverMethodAffix(t *testing.T) {
+	_ = syscall.Unlink("testdata/method_affix/out/resolver.go")
+
+	cfg, err := config.LoadConfig("testdata/method_affix/gqlgen.yml")
+	require.NoError(t, err)
+	p := Plugin{}
+
+	require.NoError(t, cfg.Init())
+
+	data, err := codegen.BuildData(cfg)
+	require.NoError(t, err)
+
+	require.NoError(t, codegen.GenerateCode(data))
+	require.NoError(t, p.GenerateCode(data))
+	assertNoErrors(t, "github.com/99designs/gqlgen/plugin/resolvergen/testdata/method_affix/out")
+
+	b, err := os.ReadFile("testdata/method_affix/out/resolver.go")
+	require.NoError(t, err)
+	source := string(b)
+
+	require.Contains(t, source, "func (r *queryCustomResolverType) ResolverResolver(ctx context.Context) (*Resolver, error) {")
+	require.Contains(t, source, "func (r *resolverCustomResolverType) NameResolver(ctx context.Context, obj *Resolver) (string, error) {")
+
+	b, err = os.ReadFile("testdata/method_affix/out/generated.go")
+	require.NoError(t, err)
+	source = string(b)
+
+	require.Contains(t, source, "return ec.resolvers.Query().ResolverResolver(rctx)")
+	require.Contains(t, source, "return ec.resolvers.Resolver().NameResolver(rctx, obj)")
+}
+
+func TestResolverNamedReturns(t *testing.T) {
+	_ = syscall.Unlink("testdata/named_returns/out/resolver.go")
+
+	cfg, err := config.LoadConfig("testdata/named_returns/gqlgen.yml")
+	require.NoError(t, err)
+	p := Plugin{}
+
+	require.NoError(t, cfg.Init())
+
+	data, err := codegen.BuildData(cfg)
+	require.NoError(t, err)
+
+	require.NoError(t, codegen.GenerateCode(data))
+	require.NoError(t, p.GenerateCode(data))
+	assertNoErrors(t, "github.com/99designs/gqlgen/plugin/resolvergen/testdata/named_returns/out")
+
+	b, err := os.ReadFile("testdata/named_returns/out/resolver.go")
+	require.NoError(t, err)
+	source := string(b)
+
+	require.Contains(t, source, "func (r *queryCustomResolverType) Resolver(ctx context.Context) (res *Resolver, err error) {")
+	require.Contains(t, source, "func (r *resolverCustomResolverType) Name(ctx context.Context, obj *Resolver) (res string, err error) {")
+
+	b, err = os.ReadFile("testdata/named_returns/out/generated.go")
+	require.NoError(t, err)
+	source = string(b)
+
+	require.Contains(t, source, "Resolver(ctx context.Context) (res *Resolver, err error)")
+	require.Contains(t, source, "Name(ctx context.Context, obj *Resolver) (res string, err error)")
+}
+
 func TestLayoutInvalidModelPath(t *testing.T) {
 	cfg, err := config.LoadConfig("testdata/invalid_model_path/gqlgen.yml")
 	require.NoError(t, err)
@@ -0,0 +1,3 @@
+package customresolver
+
+type Resolver struct{}
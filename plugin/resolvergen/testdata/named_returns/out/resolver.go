@@ -9,12 +9,12 @@ import (
 type CustomResolverType struct{}
 
 // Resolver is the resolver for the resolver field.
-func (r *queryCustomResolverType) Resolver(ctx context.Context) (*Resolver, error) {
+func (r *queryCustomResolverType) Resolver(ctx context.Context) (res *Resolver, err error) {
 	panic("not implemented")
 }
 
 // Name is the resolver for the name field.
-func (r *resolverCustomResolverType) Name(ctx context.Context, obj *Resolver) (string, error) {
+func (r *resolverCustomResolverType) Name(ctx context.Context, obj *Resolver) (res string, err error) {
 	panic("not implemented")
 }
 